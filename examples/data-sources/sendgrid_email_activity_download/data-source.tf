@@ -0,0 +1,14 @@
+# download_uuid is the last path segment of the link SendGrid emails once the
+# export requested with sendgrid_email_activity_export is ready.
+data "sendgrid_email_activity_download" "quarterly" {
+  download_uuid = var.export_download_uuid
+}
+
+variable "export_download_uuid" {
+  type = string
+}
+
+output "export_url" {
+  value     = data.sendgrid_email_activity_download.quarterly.presigned_url
+  sensitive = true
+}
@@ -0,0 +1,9 @@
+data "sendgrid_inbound_parse_stats" "last_month" {
+  start_date    = "2025-01-01"
+  end_date      = "2025-01-31"
+  aggregated_by = "week"
+}
+
+output "inbound_emails_received" {
+  value = data.sendgrid_inbound_parse_stats.last_month.total_received
+}
@@ -0,0 +1,11 @@
+data "sendgrid_marketing_automation_stats" "welcome_series" {
+  id            = "f0eb0ab3-6f5a-4b38-8d04-0a3d9f1b1f3b"
+  group_by_step = true
+}
+
+output "welcome_series_unsubscribe_rate" {
+  value = (
+    data.sendgrid_marketing_automation_stats.welcome_series.totals.unsubscribes /
+    max(data.sendgrid_marketing_automation_stats.welcome_series.totals.delivered, 1)
+  )
+}
@@ -0,0 +1,10 @@
+data "sendgrid_marketing_contacts_export" "newsletter" {
+  list_ids     = ["ca7a3796-e8a8-4029-9ccb-df8937940562"]
+  file_type    = "csv"
+  wait_timeout = "15m"
+}
+
+output "newsletter_export_urls" {
+  value     = data.sendgrid_marketing_contacts_export.newsletter.urls
+  sensitive = true
+}
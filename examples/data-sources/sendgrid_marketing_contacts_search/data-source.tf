@@ -0,0 +1,8 @@
+data "sendgrid_marketing_contacts_search" "example_domain" {
+  query = "email LIKE '%@example.com'"
+  limit = 5
+}
+
+output "example_domain_contacts" {
+  value = data.sendgrid_marketing_contacts_search.example_domain.contact_count
+}
@@ -0,0 +1,7 @@
+data "sendgrid_marketing_single_send" "launch" {
+  name = "Spring launch"
+}
+
+output "launch_is_draft" {
+  value = data.sendgrid_marketing_single_send.launch.status == "draft"
+}
@@ -0,0 +1,8 @@
+data "sendgrid_marketing_single_send_stats" "launch" {
+  id       = "27c21bbf-a12c-440b-b8bf-c2e8bd8aa8f1"
+  group_by = ["ab_variation"]
+}
+
+output "launch_unique_opens" {
+  value = data.sendgrid_marketing_single_send_stats.launch.totals.unique_opens
+}
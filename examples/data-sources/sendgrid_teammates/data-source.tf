@@ -0,0 +1,20 @@
+############################
+# Audit teammates that are not managed by Terraform
+############################
+data "sendgrid_teammates" "all" {}
+
+# E.g. the emails of every sendgrid_sso_teammate in the configuration.
+variable "managed_teammate_emails" {
+  type = set(string)
+}
+
+output "unmanaged_teammates" {
+  value = [
+    for t in data.sendgrid_teammates.all.teammates : t.username
+    if t.user_type != "owner" && !contains(var.managed_teammate_emails, t.email)
+  ]
+}
+
+output "admins" {
+  value = [for t in data.sendgrid_teammates.all.teammates : t.username if t.is_admin]
+}
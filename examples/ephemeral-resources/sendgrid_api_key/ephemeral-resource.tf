@@ -0,0 +1,18 @@
+############################
+# Transient sending key for the duration of a run
+############################
+ephemeral "sendgrid_api_key" "ci" {
+  name   = "ci-${var.pipeline_run_id}"
+  scopes = ["mail.send"]
+}
+
+variable "pipeline_run_id" {
+  type = string
+}
+
+# Ephemeral values may configure providers; the key is deleted once the run
+# no longer needs it and never appears in state or plan.
+provider "sendgrid" {
+  alias   = "sending"
+  api_key = ephemeral.sendgrid_api_key.ci.api_key
+}
@@ -0,0 +1,13 @@
+############################
+# Remediation: unblock addresses after the receiving server was fixed
+############################
+resource "sendgrid_block_entry" "remediation" {
+  emails = [
+    "billing@partner.example.com",
+    "orders@partner.example.com",
+  ]
+
+  triggers = {
+    incident = "INC-5678"
+  }
+}
@@ -0,0 +1,29 @@
+############################
+# Remove addresses that bounced during a DNS outage
+############################
+resource "sendgrid_bounce_entry" "outage" {
+  emails = [
+    "alice@example.com",
+    "bob@example.com",
+  ]
+
+  triggers = {
+    ticket = "OPS-1234"
+  }
+}
+
+############################
+# Reset the bounce list of a test account
+############################
+resource "sendgrid_bounce_entry" "reset" {
+  delete_all         = true
+  confirm_delete_all = "bounces"
+
+  triggers = {
+    run = var.reset_run
+  }
+}
+
+variable "reset_run" {
+  type = string
+}
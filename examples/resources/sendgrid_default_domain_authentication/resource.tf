@@ -0,0 +1,18 @@
+############################
+# Make an authenticated domain the default sending domain
+############################
+resource "sendgrid_default_domain_authentication" "example" {
+  domain_id = "12345678"
+}
+
+############################
+# Same for a subuser
+############################
+resource "sendgrid_default_domain_authentication" "subuser" {
+  on_behalf_of = "marketing-subuser"
+  domain_id    = "87654321"
+}
+
+output "default_sending_domain" {
+  value = sendgrid_default_domain_authentication.example.domain
+}
@@ -0,0 +1,22 @@
+############################
+# Validate an authenticated domain once its DNS records exist
+############################
+resource "sendgrid_domain_authentication_validation" "example" {
+  domain_id = "12345678"
+
+  # Keep retrying while the DNS records propagate.
+  wait_timeout = "10m"
+
+  # Re-run validation whenever the DNS records change.
+  triggers = {
+    records = var.dns_records_version
+  }
+}
+
+variable "dns_records_version" {
+  type = string
+}
+
+output "domain_validation_results" {
+  value = sendgrid_domain_authentication_validation.example.validation_results
+}
@@ -0,0 +1,14 @@
+############################
+# Quarterly compliance export of delivered messages
+############################
+resource "sendgrid_email_activity_export" "quarterly" {
+  query = "status=\"delivered\""
+
+  triggers = {
+    quarter = var.audit_quarter
+  }
+}
+
+variable "audit_quarter" {
+  type = string
+}
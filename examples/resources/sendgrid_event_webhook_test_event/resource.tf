@@ -0,0 +1,38 @@
+############################
+# Send a test event after deploying the webhook consumer
+############################
+resource "sendgrid_event_webhook_test_event" "example" {
+  url = "https://hooks.example.com/sendgrid/events"
+
+  # Any change here sends another test event, e.g. on every consumer release.
+  triggers = {
+    consumer_version = var.consumer_version
+  }
+}
+
+variable "consumer_version" {
+  type = string
+}
+
+output "webhook_test_sent_at" {
+  value = sendgrid_event_webhook_test_event.example.sent_at
+}
+
+############################
+# Check the URL currently configured on a webhook, e.g. in CI
+############################
+resource "sendgrid_event_webhook_test_event" "deployed" {
+  webhook_id = var.event_webhook_id
+
+  triggers = {
+    pipeline_run = var.pipeline_run_id
+  }
+}
+
+variable "event_webhook_id" {
+  type = string
+}
+
+variable "pipeline_run_id" {
+  type = string
+}
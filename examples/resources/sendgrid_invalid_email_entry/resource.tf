@@ -0,0 +1,15 @@
+############################
+# Reset the invalid email list of a test account
+############################
+resource "sendgrid_invalid_email_entry" "reset" {
+  delete_all         = true
+  confirm_delete_all = "invalid_emails"
+
+  triggers = {
+    run = var.reset_run
+  }
+}
+
+variable "reset_run" {
+  type = string
+}
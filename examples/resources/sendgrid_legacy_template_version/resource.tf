@@ -0,0 +1,13 @@
+############################
+# Version of an existing legacy template
+############################
+resource "sendgrid_legacy_template_version" "receipt" {
+  template_id = "3b8c2a2e-6f8e-4b2a-9c4e-5d6f7a8b9c0d"
+  name        = "receipt-2026"
+  subject     = "<%subject%>"
+
+  html_content  = file("${path.module}/templates/receipt.html") # must contain <%body%>
+  plain_content = "<%body%>"
+
+  active = true
+}
@@ -0,0 +1,26 @@
+############################
+# Gate the rest of the apply on the branded link being live
+############################
+resource "sendgrid_link_branding_validation" "example" {
+  link_id = "1234567"
+
+  # Retry while the CNAME records propagate; fail the apply if they are
+  # still not valid after 15 minutes.
+  wait_timeout = "15m"
+
+  triggers = {
+    dns_change = "2026-10-15"
+  }
+}
+
+############################
+# Validate a branded link, recording results without failing the apply
+############################
+resource "sendgrid_link_branding_validation" "report_only" {
+  link_id       = "7654321"
+  require_valid = false
+}
+
+output "link_branding_valid" {
+  value = sendgrid_link_branding_validation.report_only.valid
+}
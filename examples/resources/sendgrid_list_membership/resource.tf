@@ -0,0 +1,7 @@
+############################
+# Keep the monitoring mailbox on the release announcements list
+############################
+resource "sendgrid_list_membership" "monitoring" {
+  list_id = "ca7a3796-e8a8-4029-9ccb-df8937940562"
+  email   = "monitoring@example.com"
+}
@@ -0,0 +1,16 @@
+############################
+# Archive a copy of every email sent by the parent account
+############################
+resource "sendgrid_mail_settings_bcc" "account" {
+  enabled = true
+  email   = "archive@example.com"
+}
+
+############################
+# Same policy for a subuser
+############################
+resource "sendgrid_mail_settings_bcc" "marketing" {
+  on_behalf_of = "marketing-subuser"
+  enabled      = true
+  email        = "archive@example.com"
+}
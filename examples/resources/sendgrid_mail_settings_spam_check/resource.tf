@@ -0,0 +1,17 @@
+############################
+# Drop outbound emails that look like spam
+############################
+resource "sendgrid_mail_settings_spam_check" "account" {
+  enabled   = true
+  max_score = 5
+  url       = "https://parse.example.com/spam"
+}
+
+############################
+# Stricter policy for a subuser
+############################
+resource "sendgrid_mail_settings_spam_check" "marketing" {
+  on_behalf_of = "marketing-subuser"
+  enabled      = true
+  max_score    = 3
+}
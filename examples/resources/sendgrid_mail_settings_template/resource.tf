@@ -0,0 +1,14 @@
+############################
+# Wrap every email in the legacy company template
+############################
+resource "sendgrid_mail_settings_template" "account" {
+  enabled      = true
+  html_content = <<-EOT
+    <html>
+      <body>
+        <% body %>
+        <p>Example Inc. · 1 Example Street</p>
+      </body>
+    </html>
+  EOT
+}
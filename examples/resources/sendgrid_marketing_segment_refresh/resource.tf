@@ -0,0 +1,19 @@
+############################
+# Refresh a segment before each scheduled single send
+############################
+resource "sendgrid_marketing_segment_refresh" "newsletter" {
+  segment_id     = var.newsletter_segment_id
+  user_time_zone = "America/Chicago"
+
+  triggers = {
+    send_at = var.newsletter_send_at
+  }
+}
+
+variable "newsletter_segment_id" {
+  type = string
+}
+
+variable "newsletter_send_at" {
+  type = string
+}
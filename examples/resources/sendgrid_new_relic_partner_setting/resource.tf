@@ -0,0 +1,13 @@
+############################
+# Send email statistics to New Relic
+############################
+resource "sendgrid_new_relic_partner_setting" "account" {
+  enabled                   = true
+  license_key               = var.new_relic_license_key
+  enable_subuser_statistics = true
+}
+
+variable "new_relic_license_key" {
+  type      = string
+  sensitive = true
+}
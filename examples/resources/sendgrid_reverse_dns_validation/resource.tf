@@ -0,0 +1,21 @@
+############################
+# Validate reverse DNS of a dedicated IP once its A record exists
+############################
+resource "sendgrid_reverse_dns_validation" "example" {
+  reverse_dns_id = "123"
+
+  # Keep retrying while the A record propagates.
+  wait_timeout = "10m"
+
+  triggers = {
+    a_record = var.a_record_version
+  }
+}
+
+variable "a_record_version" {
+  type = string
+}
+
+output "reverse_dns_failure_reason" {
+  value = sendgrid_reverse_dns_validation.example.reason
+}
@@ -0,0 +1,11 @@
+############################
+# Approve a teammate's request for extra access after review
+############################
+resource "sendgrid_scope_request_decision" "bob_mail_send" {
+  request_id = "1234"
+  decision   = "approve"
+}
+
+output "approved_scope_group" {
+  value = sendgrid_scope_request_decision.bob_mail_send.scope_group_name
+}
@@ -0,0 +1,10 @@
+############################
+# Remove a spam report the recipient asked to withdraw
+############################
+resource "sendgrid_spam_report_entry" "withdrawn" {
+  emails = ["carol@example.com"]
+
+  triggers = {
+    ticket = "SUP-4321"
+  }
+}
@@ -40,6 +40,19 @@ resource "sendgrid_sso_teammate" "readonly" {
   has_restricted_subuser_access = false
 }
 
+############################
+# Persona-based main-account access
+# Note: persona is an alternative to scopes and cannot be combined with is_admin
+############################
+resource "sendgrid_sso_teammate" "developer" {
+  email = "developer@example.com"
+
+  is_admin = false
+  persona  = "developer"
+
+  has_restricted_subuser_access = false
+}
+
 ############################
 # Per-Subuser restricted access (without main-account scopes)
 ############################
@@ -61,11 +74,43 @@ resource "sendgrid_sso_teammate" "ops" {
 
   subuser_access {
     id              = "2222222"
-    permission_type = "admin" # For "admin", scopes are ignored
+    permission_type = "admin" # For "admin", scopes must be empty
     scopes          = []
   }
 }
 
+############################
+# Same access on every subuser, including subusers created later
+############################
+resource "sendgrid_sso_teammate" "support" {
+  email = "support@example.com"
+
+  is_admin = false
+
+  has_restricted_subuser_access = true
+
+  all_subusers {
+    permission_type = "restricted"
+    scopes          = ["stats.read"]
+  }
+}
+
+############################
+# Take over a teammate that was invited before the account moved to SSO
+############################
+resource "sendgrid_sso_teammate" "migrated" {
+  email = "migrated@example.com"
+
+  is_admin = false
+  scopes   = ["stats.read"]
+
+  has_restricted_subuser_access = false
+
+  # Update the existing teammate (or replace its pending invitation)
+  # instead of failing because it already exists.
+  adopt_existing = true
+}
+
 ############################
 # Useful outputs for testing
 ############################
@@ -0,0 +1,23 @@
+############################
+# Teammate managed centrally; grants owned by tenant modules
+############################
+resource "sendgrid_sso_teammate" "alice" {
+  email                         = "alice@example.com"
+  has_restricted_subuser_access = true
+  external_subuser_access       = true
+}
+
+# In the module of tenant A
+resource "sendgrid_sso_teammate_subuser_access" "alice_tenant_a" {
+  username        = sendgrid_sso_teammate.alice.email
+  subuser_id      = "12345"
+  permission_type = "restricted"
+  scopes          = ["stats.read", "templates.read"]
+}
+
+# In the module of tenant B
+resource "sendgrid_sso_teammate_subuser_access" "alice_tenant_b" {
+  username        = sendgrid_sso_teammate.alice.email
+  subuser_id      = "67890"
+  permission_type = "admin"
+}
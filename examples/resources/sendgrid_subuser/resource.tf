@@ -37,6 +37,19 @@ resource "sendgrid_subuser" "disabled_example" {
   disabled = true
 }
 
+############################
+# EU-pinned subuser
+############################
+# Requires a provider configured with base_url = "https://api.eu.sendgrid.com":
+#
+# resource "sendgrid_subuser" "eu_example" {
+#   username = "eu_subuser"
+#   email    = "eu_subuser@example.com"
+#   password = var.subuser_password
+#   ips      = ["192.0.2.20"]
+#   region   = "eu"
+# }
+
 variable "subuser_password" {
   type      = string
   sensitive = true
@@ -0,0 +1,9 @@
+############################
+# Addresses that must never receive marketing email
+############################
+resource "sendgrid_suppression_group_member" "legal" {
+  for_each = toset(["legal@example.com", "compliance@example.com"])
+
+  group_id = 12345
+  email    = each.value
+}
@@ -0,0 +1,23 @@
+############################
+# Monthly cleanup of bounces older than 90 days
+############################
+resource "sendgrid_suppression_purge" "bounces" {
+  list       = "bounces"
+  older_than = var.purge_cutoff # e.g. "2026-07-01T00:00:00Z"
+
+  triggers = {
+    month = var.purge_month
+  }
+}
+
+variable "purge_cutoff" {
+  type = string
+}
+
+variable "purge_month" {
+  type = string
+}
+
+output "bounces_deleted" {
+  value = sendgrid_suppression_purge.bounces.deleted_count
+}
@@ -0,0 +1,15 @@
+############################
+# Resend an expired teammate invitation
+############################
+resource "sendgrid_teammate_invite_resend" "example" {
+  email = "new.teammate@example.com"
+
+  # Bump to resend again, e.g. after another seven days without acceptance.
+  triggers = {
+    resend = "2026-10-15"
+  }
+}
+
+output "invite_expires_at" {
+  value = sendgrid_teammate_invite_resend.example.invite_expiration_date
+}
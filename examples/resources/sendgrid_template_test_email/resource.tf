@@ -0,0 +1,40 @@
+############################
+# Smoke-test a template after deploying it
+############################
+resource "sendgrid_template_test_email" "welcome" {
+  template_id = "d-0123456789abcdef0123456789abcdef"
+  from_email  = "noreply@example.com"
+  from_name   = "Example"
+  to          = ["qa@example.com"]
+
+  dynamic_template_data = jsonencode({
+    first_name = "Test"
+    plan       = "pro"
+  })
+
+  # Validate and render without delivering.
+  sandbox_mode = true
+
+  # Send again whenever the deployed template content changes.
+  triggers = {
+    template_version = var.template_version
+  }
+}
+
+variable "template_version" {
+  type = string
+}
+
+############################
+# Preview a template version before activating it
+############################
+resource "sendgrid_template_test_email" "welcome_next" {
+  template_id = "d-0123456789abcdef0123456789abcdef"
+  version_id  = var.next_version_id
+  from_email  = "noreply@example.com"
+  to          = ["qa@example.com", "design@example.com"]
+}
+
+variable "next_version_id" {
+  type = string
+}
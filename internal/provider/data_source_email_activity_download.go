@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NOTE: Data source that resolves an Email Activity CSV export to its download URL.
+//
+// API Endpoints:
+//   - GET /v3/messages/download/{download_uuid}
+//
+// API Documentation:
+//   - Download CSV: https://www.twilio.com/docs/sendgrid/api-reference/email-activity/download-csv
+//
+// download_uuid comes from the link SendGrid emails once an export requested
+// with sendgrid_email_activity_export is ready.
+
+var _ datasource.DataSource = (*EmailActivityDownloadDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*EmailActivityDownloadDataSource)(nil)
+
+// EmailActivityDownloadDataSource implements sendgrid_email_activity_download.
+type EmailActivityDownloadDataSource struct {
+	client *Client
+}
+
+// NewEmailActivityDownloadDataSource returns a new instance of the data source.
+func NewEmailActivityDownloadDataSource() datasource.DataSource {
+	return &EmailActivityDownloadDataSource{}
+}
+
+type emailActivityDownloadModel struct {
+	ID           types.String `tfsdk:"id"`
+	DownloadUUID types.String `tfsdk:"download_uuid"`
+	PresignedURL types.String `tfsdk:"presigned_url"`
+	CSV          types.String `tfsdk:"csv"`
+}
+
+func (d *EmailActivityDownloadDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_activity_download"
+}
+
+func (d *EmailActivityDownloadDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*Client)
+	if !ok || c == nil {
+		resp.Diagnostics.AddError("Unexpected ProviderData", "Expected *Client")
+		return
+	}
+	d.client = c
+}
+
+func (d *EmailActivityDownloadDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the presigned download URL of an Email Activity CSV export via `/v3/messages/download/{download_uuid}`. The UUID is part of the link SendGrid emails once an export requested with `sendgrid_email_activity_export` is ready.",
+		Attributes: map[string]schema.Attribute{
+			"id": dataSourceIDAttribute(),
+			"download_uuid": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "UUID of the export, taken from the emailed download link.",
+			},
+			"presigned_url": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Presigned URL of the CSV file. Anyone holding it can download the export until it expires.",
+			},
+			"csv": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Location of the CSV file as returned by SendGrid.",
+			},
+		},
+	}
+}
+
+func (d *EmailActivityDownloadDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+
+	var config emailActivityDownloadModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var download struct {
+		PresignedURL string `json:"presigned_url"`
+		CSV          string `json:"csv"`
+	}
+	if !d.client.getJSON(ctx, "/v3/messages/download/"+url.PathEscape(config.DownloadUUID.ValueString()), nil, &download, &resp.Diagnostics) {
+		return
+	}
+
+	config.ID = types.StringValue(dataSourceID("email_activity_download", map[string]attr.Value{
+		"download_uuid": config.DownloadUUID,
+	}))
+	config.PresignedURL = types.StringValue(download.PresignedURL)
+	config.CSV = stringOrNull(download.CSV)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NOTE: Data sources have no remote identity of their own, but some modules and
+// tooling still expect an `id`. Lookups expose one built from their arguments,
+// so the same configuration always yields the same id.
+
+// dataSourceIDAttribute is the computed `id` attribute shared by lookup data
+// sources.
+func dataSourceIDAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Identifier derived from the data source arguments.",
+	}
+}
+
+// dataSourceID returns name followed by the set arguments as a sorted query
+// string, e.g. `teammate?username=a%40example.com`. Null and unknown values
+// are left out.
+func dataSourceID(name string, args map[string]attr.Value) string {
+	q := make(url.Values, len(args))
+	for k, v := range args {
+		if v == nil || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+		switch tv := v.(type) {
+		case types.String:
+			q.Set(k, tv.ValueString())
+		case types.Int64:
+			q.Set(k, strconv.FormatInt(tv.ValueInt64(), 10))
+		case types.Bool:
+			q.Set(k, strconv.FormatBool(tv.ValueBool()))
+		default:
+			q.Set(k, v.String())
+		}
+	}
+	if len(q) == 0 {
+		return name
+	}
+	return name + "?" + q.Encode()
+}
@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDataSourceID(t *testing.T) {
+	got := dataSourceID("teammate_subuser_access", map[string]attr.Value{
+		"teammate_name":    types.StringValue("a@example.com"),
+		"limit":            types.Int64Value(10),
+		"fetch_all":        types.BoolValue(true),
+		"username":         types.StringNull(),
+		"after_subuser_id": types.Int64Unknown(),
+	})
+	if want := "teammate_subuser_access?fetch_all=true&limit=10&teammate_name=a%40example.com"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if got := dataSourceID("subusers", map[string]attr.Value{"region": types.StringNull()}); got != "subusers" {
+		t.Fatalf("expected bare name without arguments, got %q", got)
+	}
+}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NOTE: Data source for the number of emails received by Inbound Parse.
+//
+// API Endpoints:
+//   - GET /v3/user/webhooks/parse/stats?start_date&end_date&aggregated_by
+//
+// API Documentation:
+//   - Retrieves Inbound Parse Webhook statistics: https://www.twilio.com/docs/sendgrid/api-reference/webhooks/retrieves-inbound-parse-webhook-statistics
+//
+// The response is [{date, stats: [{metrics: {received}}]}], one element per
+// day, week or month.
+
+var _ datasource.DataSource = (*InboundParseStatsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*InboundParseStatsDataSource)(nil)
+var _ datasource.DataSourceWithValidateConfig = (*InboundParseStatsDataSource)(nil)
+
+// InboundParseStatsDataSource implements sendgrid_inbound_parse_stats.
+type InboundParseStatsDataSource struct {
+	client *Client
+}
+
+// NewInboundParseStatsDataSource returns a new instance of the data source.
+func NewInboundParseStatsDataSource() datasource.DataSource {
+	return &InboundParseStatsDataSource{}
+}
+
+type inboundParseStatsModel struct {
+	ID           types.String `tfsdk:"id"`
+	StartDate    types.String `tfsdk:"start_date"`
+	EndDate      types.String `tfsdk:"end_date"`
+	AggregatedBy types.String `tfsdk:"aggregated_by"`
+
+	TotalReceived types.Int64 `tfsdk:"total_received"`
+	Results       types.List  `tfsdk:"results"`
+}
+
+// inboundParseStatsResult is one period of parse stats, with the received
+// count summed over its stats entries.
+type inboundParseStatsResult struct {
+	Date     string
+	Received int64
+}
+
+func inboundParseStatsResultType() types.ObjectType {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"date":     types.StringType,
+		"received": types.Int64Type,
+	}}
+}
+
+func (d *InboundParseStatsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inbound_parse_stats"
+}
+
+func (d *InboundParseStatsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*Client)
+	if !ok || c == nil {
+		resp.Diagnostics.AddError("Unexpected ProviderData", "Expected *Client")
+		return
+	}
+	d.client = c
+}
+
+func (d *InboundParseStatsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Read how many emails Inbound Parse received via `/v3/user/webhooks/parse/stats`, e.g. to monitor inbound volume next to the parse webhook configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": dataSourceIDAttribute(),
+			"start_date": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "First day to include, `YYYY-MM-DD`.",
+				Validators:          []validator.String{statsDateValidator{}},
+			},
+			"end_date": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Last day to include, `YYYY-MM-DD`, not before `start_date`. Defaults to today.",
+				Validators:          []validator.String{statsDateValidator{}},
+			},
+			"aggregated_by": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "`day` (API default), `week` or `month`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("day", "week", "month"),
+				},
+			},
+			"total_received": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Sum of `received` over all `results`.",
+			},
+			"results": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "One element per period, as returned by the API.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"date": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "First day (`YYYY-MM-DD`) of the period.",
+						},
+						"received": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of emails received in the period.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *InboundParseStatsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	validateMarketingStatsRange(ctx, req.Config, &resp.Diagnostics)
+}
+
+func (d *InboundParseStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+
+	var config inboundParseStatsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := map[string]string{}
+	for k, v := range map[string]types.String{
+		"start_date":    config.StartDate,
+		"end_date":      config.EndDate,
+		"aggregated_by": config.AggregatedBy,
+	} {
+		if !v.IsNull() {
+			query[k] = v.ValueString()
+		}
+	}
+
+	results, diags := d.client.inboundParseStats(ctx, query)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var total int64
+	elems := make([]attr.Value, 0, len(results))
+	for _, res := range results {
+		total += res.Received
+		obj, d := types.ObjectValue(inboundParseStatsResultType().AttrTypes, map[string]attr.Value{
+			"date":     types.StringValue(res.Date),
+			"received": types.Int64Value(res.Received),
+		})
+		resp.Diagnostics.Append(d...)
+		elems = append(elems, obj)
+	}
+	list, diags := types.ListValue(inboundParseStatsResultType(), elems)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.ID = types.StringValue(dataSourceID("inbound_parse_stats", map[string]attr.Value{
+		"start_date":    config.StartDate,
+		"end_date":      config.EndDate,
+		"aggregated_by": config.AggregatedBy,
+	}))
+	config.TotalReceived = types.Int64Value(total)
+	config.Results = list
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// inboundParseStats fetches the parse stats for query and sums the received
+// metric of each period.
+func (c *Client) inboundParseStats(ctx context.Context, query map[string]string) ([]inboundParseStatsResult, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var periods []struct {
+		Date  string `json:"date"`
+		Stats []struct {
+			Metrics struct {
+				Received int64 `json:"received"`
+			} `json:"metrics"`
+		} `json:"stats"`
+	}
+	if !c.getJSON(ctx, "/v3/user/webhooks/parse/stats", query, &periods, &diags) {
+		return nil, diags
+	}
+	out := make([]inboundParseStatsResult, 0, len(periods))
+	for _, p := range periods {
+		res := inboundParseStatsResult{Date: p.Date}
+		for _, s := range p.Stats {
+			res.Received += s.Metrics.Received
+		}
+		out = append(out, res)
+	}
+	return out, diags
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestInboundParseStats_SumsReceived(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/user/webhooks/parse/stats" {
+			http.NotFound(w, r)
+			return
+		}
+		if got := r.URL.Query().Get("aggregated_by"); got != "week" {
+			t.Errorf("aggregated_by = %q", got)
+		}
+		_, _ = w.Write([]byte(`[
+			{"date":"2025-01-06","stats":[{"metrics":{"received":3}},{"metrics":{"received":4}}]},
+			{"date":"2025-01-13","stats":[]}
+		]`))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, APIKey: "test-key"}
+	results, diags := c.inboundParseStats(context.Background(), map[string]string{"start_date": "2025-01-06", "aggregated_by": "week"})
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if len(results) != 2 || results[0].Received != 7 || results[1].Date != "2025-01-13" || results[1].Received != 0 {
+		t.Fatalf("unexpected results: %+v", results)
+	}
+}
@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NOTE: Data source for the stats of one Marketing Campaigns automation.
+//
+// API Endpoints:
+//   - GET /v3/marketing/stats/automations/{id}?group_by&step_ids&aggregated_by&start_date&end_date&timezone
+//
+// API Documentation:
+//   - Get Automation Stats by ID: https://www.twilio.com/docs/sendgrid/api-reference/marketing-campaign-stats/get-automation-stats-by-id
+//
+// The public API has no endpoint returning an automation's own metadata
+// (name, status), so only its statistics are exposed.
+
+var _ datasource.DataSource = (*MarketingAutomationStatsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*MarketingAutomationStatsDataSource)(nil)
+var _ datasource.DataSourceWithValidateConfig = (*MarketingAutomationStatsDataSource)(nil)
+
+// MarketingAutomationStatsDataSource implements sendgrid_marketing_automation_stats.
+type MarketingAutomationStatsDataSource struct {
+	client *Client
+}
+
+// NewMarketingAutomationStatsDataSource returns a new instance of the data source.
+func NewMarketingAutomationStatsDataSource() datasource.DataSource {
+	return &MarketingAutomationStatsDataSource{}
+}
+
+type marketingAutomationStatsModel struct {
+	ID           types.String `tfsdk:"id"`
+	GroupByStep  types.Bool   `tfsdk:"group_by_step"`
+	StepIDs      types.List   `tfsdk:"step_ids"`
+	AggregatedBy types.String `tfsdk:"aggregated_by"`
+	StartDate    types.String `tfsdk:"start_date"`
+	EndDate      types.String `tfsdk:"end_date"`
+	Timezone     types.String `tfsdk:"timezone"`
+
+	Totals  types.Object `tfsdk:"totals"`
+	Results types.List   `tfsdk:"results"`
+}
+
+func marketingAutomationStatsResultType() types.ObjectType {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"step_id":     types.StringType,
+		"aggregation": types.StringType,
+		"stats":       marketingStatsObjectType(),
+	}}
+}
+
+func (d *MarketingAutomationStatsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_marketing_automation_stats"
+}
+
+func (d *MarketingAutomationStatsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*Client)
+	if !ok || c == nil {
+		resp.Diagnostics.AddError("Unexpected ProviderData", "Expected *Client")
+		return
+	}
+	d.client = c
+}
+
+func (d *MarketingAutomationStatsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Read the statistics of one Marketing Campaigns automation via `/v3/marketing/stats/automations/{id}`, e.g. to define alerting thresholds next to the rest of the account configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Automation ID.",
+			},
+			"group_by_step": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "If true, `results` has one element per automation step (`group_by=step_id`).",
+			},
+			"step_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Only include these step IDs.",
+			},
+			"totals": marketingStatsSchema("Sum of the metrics over all `results`."),
+			"results": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Results as returned by the API, one per step and/or day depending on the grouping.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"step_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Step ID, when grouped by step.",
+						},
+						"aggregation": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "`total` or the day (`YYYY-MM-DD`) of this result.",
+						},
+						"stats": marketingStatsSchema("Metrics of this result."),
+					},
+				},
+			},
+		},
+	}
+	for name, attr := range marketingStatsRangeAttributes() {
+		resp.Schema.Attributes[name] = attr
+	}
+}
+
+func (d *MarketingAutomationStatsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	validateMarketingStatsRange(ctx, req.Config, &resp.Diagnostics)
+}
+
+func (d *MarketingAutomationStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+
+	var config marketingAutomationStatsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := map[string]string{}
+	if config.GroupByStep.ValueBool() {
+		query["group_by"] = "step_id"
+	}
+	if !config.StepIDs.IsNull() {
+		var ids []string
+		resp.Diagnostics.Append(config.StepIDs.ElementsAs(ctx, &ids, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		query["step_ids"] = strings.Join(ids, ",")
+	}
+	for k, v := range map[string]types.String{
+		"aggregated_by": config.AggregatedBy,
+		"start_date":    config.StartDate,
+		"end_date":      config.EndDate,
+		"timezone":      config.Timezone,
+	} {
+		if !v.IsNull() {
+			query[k] = v.ValueString()
+		}
+	}
+
+	results, diags := d.client.marketingStatsResults(ctx, "/v3/marketing/stats/automations/"+url.PathEscape(config.ID.ValueString()), query)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var totals marketingStats
+	elems := make([]attr.Value, 0, len(results))
+	for _, res := range results {
+		totals.add(res.Stats)
+		stats, d := marketingStatsToObject(res.Stats)
+		resp.Diagnostics.Append(d...)
+		obj, d := types.ObjectValue(marketingAutomationStatsResultType().AttrTypes, map[string]attr.Value{
+			"step_id":     stringOrNull(res.StepID),
+			"aggregation": types.StringValue(res.Aggregation),
+			"stats":       stats,
+		})
+		resp.Diagnostics.Append(d...)
+		elems = append(elems, obj)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	totalsObj, diags := marketingStatsToObject(totals)
+	resp.Diagnostics.Append(diags...)
+	list, diags := types.ListValue(marketingAutomationStatsResultType(), elems)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.Totals = totalsObj
+	config.Results = list
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,295 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sendgrid/sendgrid-go"
+)
+
+// NOTE: Data source that exports Marketing Campaigns contacts.
+//
+// API Endpoints:
+//   - Start:  POST /v3/marketing/contacts/exports
+//   - Status: GET  /v3/marketing/contacts/exports/{id}
+//
+// API Documentation:
+//   - Export Contacts:       https://www.twilio.com/docs/sendgrid/api-reference/contacts/export-contacts
+//   - Export Contacts Status: https://www.twilio.com/docs/sendgrid/api-reference/contacts/export-contacts-status
+//
+// Every read starts a new export and polls it until it is ready, so each plan
+// produces fresh download URLs.
+
+// contactsExportPollInterval is the delay between export status polls. A
+// variable so tests can shorten it.
+var contactsExportPollInterval = 5 * time.Second
+
+// defaultContactsExportTimeout bounds polling when wait_timeout is unset.
+const defaultContactsExportTimeout = 10 * time.Minute
+
+var _ datasource.DataSource = (*MarketingContactsExportDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*MarketingContactsExportDataSource)(nil)
+
+// MarketingContactsExportDataSource implements sendgrid_marketing_contacts_export.
+type MarketingContactsExportDataSource struct {
+	client *Client
+}
+
+// NewMarketingContactsExportDataSource returns a new instance of the data source.
+func NewMarketingContactsExportDataSource() datasource.DataSource {
+	return &MarketingContactsExportDataSource{}
+}
+
+type marketingContactsExportModel struct {
+	ListIDs     types.List   `tfsdk:"list_ids"`
+	SegmentIDs  types.List   `tfsdk:"segment_ids"`
+	FileType    types.String `tfsdk:"file_type"`
+	MaxFileSize types.Int64  `tfsdk:"max_file_size"`
+	WaitTimeout types.String `tfsdk:"wait_timeout"`
+
+	ID          types.String `tfsdk:"id"`
+	Status      types.String `tfsdk:"status"`
+	URLs        types.List   `tfsdk:"urls"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	CompletedAt types.String `tfsdk:"completed_at"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+}
+
+func (d *MarketingContactsExportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_marketing_contacts_export"
+}
+
+func (d *MarketingContactsExportDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*Client)
+	if !ok || c == nil {
+		resp.Diagnostics.AddError("Unexpected ProviderData", "Expected *Client")
+		return
+	}
+	d.client = c
+}
+
+func (d *MarketingContactsExportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Export Marketing Campaigns contacts via `POST /v3/marketing/contacts/exports` and wait until the export is ready. Every read starts a new export, so each plan returns fresh download URLs. Without `list_ids` or `segment_ids` all contacts are exported.",
+		Attributes: map[string]schema.Attribute{
+			"list_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "IDs of the contact lists to export.",
+			},
+			"segment_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "IDs of the segments to export.",
+			},
+			"file_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "`csv` (API default) or `json`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("csv", "json"),
+				},
+			},
+			"max_file_size": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum size of each export file in MB; larger exports are split into several files.",
+			},
+			"wait_timeout": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Maximum time to wait for the export, as a Go duration (e.g., `5m`). Defaults to `%s`.", defaultContactsExportTimeout),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Export ID.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Final export status (`ready`).",
+			},
+			"urls": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Presigned download URLs of the export files. Anyone holding them can download the contacts until `expires_at`.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the export was requested.",
+			},
+			"completed_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the export finished.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the download URLs expire.",
+			},
+		},
+	}
+}
+
+// contactsExportResponse is the body of GET /v3/marketing/contacts/exports/{id}.
+type contactsExportResponse struct {
+	ID          string   `json:"id"`
+	Status      string   `json:"status"` // pending|ready|failure
+	URLs        []string `json:"urls"`
+	Message     string   `json:"message"`
+	CreatedAt   string   `json:"created_at"`
+	CompletedAt string   `json:"completed_at"`
+	ExpiresAt   string   `json:"expires_at"`
+}
+
+func (d *MarketingContactsExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+
+	var config marketingContactsExportModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := defaultContactsExportTimeout
+	if !config.WaitTimeout.IsNull() && !config.WaitTimeout.IsUnknown() {
+		t, err := time.ParseDuration(config.WaitTimeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("wait_timeout"), "Invalid wait_timeout", err.Error())
+			return
+		}
+		timeout = t
+	}
+
+	payload := map[string]any{}
+	if !config.ListIDs.IsNull() {
+		var ids []string
+		resp.Diagnostics.Append(config.ListIDs.ElementsAs(ctx, &ids, false)...)
+		payload["list_ids"] = ids
+	}
+	if !config.SegmentIDs.IsNull() {
+		var ids []string
+		resp.Diagnostics.Append(config.SegmentIDs.ElementsAs(ctx, &ids, false)...)
+		payload["segment_ids"] = ids
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.FileType.IsNull() {
+		payload["file_type"] = config.FileType.ValueString()
+	}
+	if !config.MaxFileSize.IsNull() {
+		payload["max_file_size"] = config.MaxFileSize.ValueInt64()
+	}
+
+	id, diags := d.client.startContactsExport(ctx, payload)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	export, diags := d.client.waitForContactsExport(ctx, id, timeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	urls, diags := types.ListValueFrom(ctx, types.StringType, export.URLs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.ID = types.StringValue(export.ID)
+	config.Status = types.StringValue(export.Status)
+	config.URLs = urls
+	config.CreatedAt = types.StringValue(export.CreatedAt)
+	config.CompletedAt = types.StringValue(export.CompletedAt)
+	config.ExpiresAt = types.StringValue(export.ExpiresAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// startContactsExport requests a new export and returns its ID.
+// POST /v3/marketing/contacts/exports
+func (c *Client) startContactsExport(ctx context.Context, payload map[string]any) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	b, _ := json.Marshal(payload)
+	reqSG := sendgrid.GetRequest(c.APIKey, "/v3/marketing/contacts/exports", c.BaseURL)
+	reqSG.Method = "POST"
+	reqSG.Body = b
+
+	tflog.Debug(ctx, "POST /v3/marketing/contacts/exports")
+
+	sgResp, err := sendgrid.API(reqSG)
+	c.invalidateGetCache()
+	if err != nil {
+		diags.AddError("SendGrid API error", err.Error())
+		return "", diags
+	}
+	diags.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+	if sgResp.StatusCode >= 300 {
+		diags.AddError(
+			fmt.Sprintf("Export contacts failed: %s", apiErrorMessage(sgResp.Body)),
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+		return "", diags
+	}
+
+	var started struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal([]byte(sgResp.Body), &started); err != nil || started.ID == "" {
+		diags.AddError("Parse error (export contacts)", fmt.Sprintf("unable to read export id from body: %s", sgResp.Body))
+		return "", diags
+	}
+	return started.ID, diags
+}
+
+// waitForContactsExport polls the export until it is ready, errors on
+// failure, or gives up once timeout elapses.
+// GET /v3/marketing/contacts/exports/{id}
+func (c *Client) waitForContactsExport(ctx context.Context, id string, timeout time.Duration) (contactsExportResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	deadline := time.Now().Add(timeout)
+	for {
+		var export contactsExportResponse
+		// Every poll must reach the API rather than the GET cache.
+		c.forgetGet("/v3/marketing/contacts/exports/"+id, nil)
+		if !c.getJSON(ctx, "/v3/marketing/contacts/exports/"+id, nil, &export, &diags) {
+			return export, diags
+		}
+		tflog.Debug(ctx, "Waiting for contacts export", map[string]any{"id": id, "status": export.Status})
+		switch export.Status {
+		case "ready":
+			return export, diags
+		case "failure":
+			diags.AddError("Contacts export failed",
+				fmt.Sprintf("export %s failed: %s", id, export.Message))
+			return export, diags
+		}
+		if time.Now().Add(contactsExportPollInterval).After(deadline) {
+			diags.AddError("Timed out waiting for contacts export",
+				fmt.Sprintf("export %s was still %q after %s", id, export.Status, timeout))
+			return export, diags
+		}
+		select {
+		case <-ctx.Done():
+			diags.AddError("Canceled waiting for contacts export", ctx.Err().Error())
+			return export, diags
+		case <-time.After(contactsExportPollInterval):
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestContactsExport_StartAndWait(t *testing.T) {
+	old := contactsExportPollInterval
+	contactsExportPollInterval = time.Millisecond
+	defer func() { contactsExportPollInterval = old }()
+
+	polls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v3/marketing/contacts/exports":
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body["file_type"] != "json" {
+				t.Errorf("file_type = %v", body["file_type"])
+			}
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"id":"exp-1"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v3/marketing/contacts/exports/exp-1":
+			polls++
+			status := "pending"
+			if polls == 3 {
+				status = "ready"
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id": "exp-1", "status": status, "urls": []string{"https://example.com/a.json"},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, APIKey: "test-key"}
+	id, diags := c.startContactsExport(context.Background(), map[string]any{"file_type": "json"})
+	if diags.HasError() || id != "exp-1" {
+		t.Fatalf("start: id=%q diags=%v", id, diags)
+	}
+	got, diags := c.waitForContactsExport(context.Background(), id, time.Minute)
+	if diags.HasError() {
+		t.Fatalf("wait: %v", diags)
+	}
+	if polls != 3 || got.Status != "ready" || len(got.URLs) != 1 {
+		t.Fatalf("unexpected result after %d polls: %+v", polls, got)
+	}
+}
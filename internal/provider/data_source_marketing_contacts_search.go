@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sendgrid/sendgrid-go"
+)
+
+// NOTE: Data source that runs an SGQL query against Marketing Campaigns contacts.
+//
+// API Endpoints:
+//   - POST /v3/marketing/contacts/search
+//
+// API Documentation:
+//   - Search Contacts: https://www.twilio.com/docs/sendgrid/api-reference/contacts/search-contacts
+//
+// The endpoint returns contact_count and at most 50 matching contacts; it has
+// no limit parameter, so `limit` trims the sample client-side. Searching does
+// not change anything, so the GET cache is left alone.
+
+// defaultContactsSearchLimit is the sample size when limit is unset.
+const defaultContactsSearchLimit = 10
+
+var _ datasource.DataSource = (*MarketingContactsSearchDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*MarketingContactsSearchDataSource)(nil)
+
+// MarketingContactsSearchDataSource implements sendgrid_marketing_contacts_search.
+type MarketingContactsSearchDataSource struct {
+	client *Client
+}
+
+// NewMarketingContactsSearchDataSource returns a new instance of the data source.
+func NewMarketingContactsSearchDataSource() datasource.DataSource {
+	return &MarketingContactsSearchDataSource{}
+}
+
+type marketingContactsSearchModel struct {
+	ID    types.String `tfsdk:"id"`
+	Query types.String `tfsdk:"query"`
+	Limit types.Int64  `tfsdk:"limit"`
+
+	ContactCount types.Int64 `tfsdk:"contact_count"`
+	Contacts     types.List  `tfsdk:"contacts"`
+}
+
+// contactsSearchContact is one element of the search result.
+type contactsSearchContact struct {
+	ID        string   `json:"id"`
+	Email     string   `json:"email"`
+	FirstName string   `json:"first_name"`
+	LastName  string   `json:"last_name"`
+	ListIDs   []string `json:"list_ids"`
+	CreatedAt string   `json:"created_at"`
+	UpdatedAt string   `json:"updated_at"`
+}
+
+// contactsSearchResponse is the body of POST /v3/marketing/contacts/search.
+type contactsSearchResponse struct {
+	Result       []contactsSearchContact `json:"result"`
+	ContactCount int64                   `json:"contact_count"`
+}
+
+func marketingContactsSearchContactType() types.ObjectType {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"id":         types.StringType,
+		"email":      types.StringType,
+		"first_name": types.StringType,
+		"last_name":  types.StringType,
+		"list_ids":   types.ListType{ElemType: types.StringType},
+		"created_at": types.StringType,
+		"updated_at": types.StringType,
+	}}
+}
+
+func (d *MarketingContactsSearchDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_marketing_contacts_search"
+}
+
+func (d *MarketingContactsSearchDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*Client)
+	if !ok || c == nil {
+		resp.Diagnostics.AddError("Unexpected ProviderData", "Expected *Client")
+		return
+	}
+	d.client = c
+}
+
+func (d *MarketingContactsSearchDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Run an SGQL query against Marketing Campaigns contacts via `POST /v3/marketing/contacts/search` and return the number of matches plus a sample, e.g. to check segment logic before creating a segment from the same query.",
+		Attributes: map[string]schema.Attribute{
+			"id": dataSourceIDAttribute(),
+			"query": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "SGQL `WHERE` clause, e.g. `email LIKE '%@example.com' AND CONTAINS(list_ids, '<list-id>')`.",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Maximum number of contacts returned in `contacts`, between 1 and 50. Defaults to `%d`. Does not affect `contact_count`.", defaultContactsSearchLimit),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
+			},
+			"contact_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Total number of contacts matching `query`.",
+			},
+			"contacts": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Sample of the matching contacts, at most `limit`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Contact ID.",
+						},
+						"email": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Contact email address.",
+						},
+						"first_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Contact first name.",
+						},
+						"last_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Contact last name.",
+						},
+						"list_ids": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "IDs of the lists the contact belongs to.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the contact was created.",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the contact was last updated.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MarketingContactsSearchDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+
+	var config marketingContactsSearchModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := int64(defaultContactsSearchLimit)
+	if !config.Limit.IsNull() {
+		limit = config.Limit.ValueInt64()
+	}
+
+	found, diags := d.client.searchContacts(ctx, config.Query.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sample := found.Result
+	if int64(len(sample)) > limit {
+		sample = sample[:limit]
+	}
+	elems := make([]attr.Value, 0, len(sample))
+	for _, c := range sample {
+		listIDs, d := types.ListValueFrom(ctx, types.StringType, c.ListIDs)
+		resp.Diagnostics.Append(d...)
+		obj, d := types.ObjectValue(marketingContactsSearchContactType().AttrTypes, map[string]attr.Value{
+			"id":         types.StringValue(c.ID),
+			"email":      types.StringValue(c.Email),
+			"first_name": stringOrNull(c.FirstName),
+			"last_name":  stringOrNull(c.LastName),
+			"list_ids":   listIDs,
+			"created_at": stringOrNull(c.CreatedAt),
+			"updated_at": stringOrNull(c.UpdatedAt),
+		})
+		resp.Diagnostics.Append(d...)
+		elems = append(elems, obj)
+	}
+	list, diags := types.ListValue(marketingContactsSearchContactType(), elems)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.ID = types.StringValue(dataSourceID("marketing_contacts_search", map[string]attr.Value{
+		"query": config.Query,
+		"limit": config.Limit,
+	}))
+	config.ContactCount = types.Int64Value(found.ContactCount)
+	config.Contacts = list
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// searchContacts runs an SGQL query.
+// POST /v3/marketing/contacts/search
+func (c *Client) searchContacts(ctx context.Context, query string) (contactsSearchResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var out contactsSearchResponse
+
+	b, _ := json.Marshal(map[string]string{"query": query})
+	reqSG := sendgrid.GetRequest(c.APIKey, "/v3/marketing/contacts/search", c.BaseURL)
+	reqSG.Method = "POST"
+	reqSG.Body = b
+
+	tflog.Debug(ctx, "POST /v3/marketing/contacts/search", map[string]any{"query": query})
+
+	sgResp, err := sendgrid.API(reqSG)
+	if err != nil {
+		diags.AddError("SendGrid API error", err.Error())
+		return out, diags
+	}
+	diags.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+	if sgResp.StatusCode >= 300 {
+		diags.AddError(
+			fmt.Sprintf("Search contacts failed: %s", apiErrorMessage(sgResp.Body)),
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+		return out, diags
+	}
+	if err := json.Unmarshal([]byte(sgResp.Body), &out); err != nil {
+		diags.AddError("Parse error (search contacts)", fmt.Sprintf("unable to parse body: %v", err))
+	}
+	return out, diags
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSearchContacts(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v3/marketing/contacts/search" {
+			http.NotFound(w, r)
+			return
+		}
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body["query"] != "email LIKE '%@example.com'" {
+			t.Errorf("query = %q", body["query"])
+		}
+		_, _ = w.Write([]byte(`{"result":[{"id":"c1","email":"a@example.com","list_ids":["l1"]}],"contact_count":42}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, APIKey: "test-key"}
+	got, diags := c.searchContacts(context.Background(), "email LIKE '%@example.com'")
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if got.ContactCount != 42 || len(got.Result) != 1 || got.Result[0].ListIDs[0] != "l1" {
+		t.Fatalf("unexpected result: %+v", got)
+	}
+
+	srv.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"errors":[{"field":"query","message":"invalid SGQL"}]}`))
+	})
+	if _, diags := c.searchContacts(context.Background(), "nope"); !diags.HasError() {
+		t.Fatal("expected an error for an invalid query")
+	}
+}
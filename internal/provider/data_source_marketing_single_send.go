@@ -0,0 +1,283 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NOTE: Data source that looks up one Marketing Campaigns single send.
+//
+// API Endpoints:
+//   - By ID:   GET /v3/marketing/singlesends/{id}
+//   - By name: GET /v3/marketing/singlesends?page_size&page_token, then GET by ID
+//
+// API Documentation:
+//   - Get Single Send by ID: https://www.twilio.com/docs/sendgrid/api-reference/single-sends/get-single-send-by-id
+//   - Get All Single Sends: https://www.twilio.com/docs/sendgrid/api-reference/single-sends/get-all-single-sends
+//
+// The list endpoint pages with page_token, read from _metadata.next like the
+// stats endpoints. Names are not unique in SendGrid, so a lookup by name fails
+// unless exactly one single send carries it.
+
+// singleSendListPageSize is the page_size used when looking a single send up by name.
+const singleSendListPageSize = 100
+
+var _ datasource.DataSource = (*MarketingSingleSendDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*MarketingSingleSendDataSource)(nil)
+
+// MarketingSingleSendDataSource implements sendgrid_marketing_single_send.
+type MarketingSingleSendDataSource struct {
+	client *Client
+}
+
+// NewMarketingSingleSendDataSource returns a new instance of the data source.
+func NewMarketingSingleSendDataSource() datasource.DataSource {
+	return &MarketingSingleSendDataSource{}
+}
+
+type marketingSingleSendModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Status     types.String `tfsdk:"status"`
+	SendAt     types.String `tfsdk:"send_at"`
+	Categories types.List   `tfsdk:"categories"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+
+	EmailConfig *singleSendEmailConfigModel `tfsdk:"email_config"`
+}
+
+type singleSendEmailConfigModel struct {
+	Subject              types.String `tfsdk:"subject"`
+	SenderID             types.Int64  `tfsdk:"sender_id"`
+	SuppressionGroupID   types.Int64  `tfsdk:"suppression_group_id"`
+	CustomUnsubscribeURL types.String `tfsdk:"custom_unsubscribe_url"`
+	DesignID             types.String `tfsdk:"design_id"`
+	Editor               types.String `tfsdk:"editor"`
+	IPPool               types.String `tfsdk:"ip_pool"`
+}
+
+// singleSend is the body of GET /v3/marketing/singlesends/{id}.
+type singleSend struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Status      string   `json:"status"`
+	SendAt      string   `json:"send_at"`
+	Categories  []string `json:"categories"`
+	CreatedAt   string   `json:"created_at"`
+	UpdatedAt   string   `json:"updated_at"`
+	EmailConfig struct {
+		Subject              string `json:"subject"`
+		SenderID             *int64 `json:"sender_id"`
+		SuppressionGroupID   *int64 `json:"suppression_group_id"`
+		CustomUnsubscribeURL string `json:"custom_unsubscribe_url"`
+		DesignID             string `json:"design_id"`
+		Editor               string `json:"editor"`
+		IPPool               string `json:"ip_pool"`
+	} `json:"email_config"`
+}
+
+func (d *MarketingSingleSendDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_marketing_single_send"
+}
+
+func (d *MarketingSingleSendDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*Client)
+	if !ok || c == nil {
+		resp.Diagnostics.AddError("Unexpected ProviderData", "Expected *Client")
+		return
+	}
+	d.client = c
+}
+
+func (d *MarketingSingleSendDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Look up one Marketing Campaigns single send by `id` or by `name` via `/v3/marketing/singlesends`, e.g. to check that a campaign is still a `draft` before changing its schedule.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "ID of the single send. Exactly one of `id` and `name` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Name of the single send. The lookup fails unless exactly one single send has this name.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Status of the single send: `draft`, `scheduled`, or `triggered`.",
+			},
+			"send_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the single send is (or was) sent, in ISO 8601. Null while unscheduled.",
+			},
+			"categories": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Categories of the single send.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the single send was created.",
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the single send was last updated.",
+			},
+			"email_config": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Summary of the email settings. The content (`html_content`, `plain_content`) is not exposed.",
+				Attributes: map[string]schema.Attribute{
+					"subject": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Email subject.",
+					},
+					"sender_id": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "ID of the verified sender.",
+					},
+					"suppression_group_id": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "ID of the unsubscribe group. Null when `custom_unsubscribe_url` is used.",
+					},
+					"custom_unsubscribe_url": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Custom unsubscribe URL.",
+					},
+					"design_id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "ID of the design the content was created from.",
+					},
+					"editor": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Editor used for the content: `code` or `design`.",
+					},
+					"ip_pool": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "IP pool the single send is sent from.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MarketingSingleSendDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+
+	var config marketingSingleSendModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := config.ID.ValueString()
+	if id == "" {
+		found, diags := d.client.findSingleSendByName(ctx, config.Name.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		id = found
+	}
+
+	var ss singleSend
+	if !d.client.getJSON(ctx, "/v3/marketing/singlesends/"+url.PathEscape(id), nil, &ss, &resp.Diagnostics) {
+		return
+	}
+
+	categories, diags := types.ListValueFrom(ctx, types.StringType, ss.Categories)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.ID = types.StringValue(ss.ID)
+	config.Name = types.StringValue(ss.Name)
+	config.Status = types.StringValue(ss.Status)
+	config.SendAt = stringOrNull(ss.SendAt)
+	config.Categories = categories
+	config.CreatedAt = stringOrNull(ss.CreatedAt)
+	config.UpdatedAt = stringOrNull(ss.UpdatedAt)
+	config.EmailConfig = &singleSendEmailConfigModel{
+		Subject:              stringOrNull(ss.EmailConfig.Subject),
+		SenderID:             types.Int64PointerValue(ss.EmailConfig.SenderID),
+		SuppressionGroupID:   types.Int64PointerValue(ss.EmailConfig.SuppressionGroupID),
+		CustomUnsubscribeURL: stringOrNull(ss.EmailConfig.CustomUnsubscribeURL),
+		DesignID:             stringOrNull(ss.EmailConfig.DesignID),
+		Editor:               stringOrNull(ss.EmailConfig.Editor),
+		IPPool:               stringOrNull(ss.EmailConfig.IPPool),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// findSingleSendByName walks every page of GET /v3/marketing/singlesends and
+// returns the ID of the only single send named name.
+func (c *Client) findSingleSendByName(ctx context.Context, name string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var ids []string
+
+	q := map[string]string{"page_size": strconv.Itoa(singleSendListPageSize)}
+	for {
+		var page struct {
+			Result []struct {
+				ID   string `json:"id"`
+				Name string `json:"name"`
+			} `json:"result"`
+			Metadata struct {
+				Next string `json:"next"`
+			} `json:"_metadata"`
+		}
+		if !c.getJSON(ctx, "/v3/marketing/singlesends", q, &page, &diags) {
+			return "", diags
+		}
+		for _, ss := range page.Result {
+			if ss.Name == name {
+				ids = append(ids, ss.ID)
+			}
+		}
+
+		token := ""
+		if page.Metadata.Next != "" {
+			if u, err := url.Parse(page.Metadata.Next); err == nil {
+				token = u.Query().Get("page_token")
+			}
+		}
+		if token == "" || len(page.Result) == 0 {
+			break
+		}
+		q["page_token"] = token
+	}
+
+	switch len(ids) {
+	case 0:
+		diags.AddError("Single send not found", fmt.Sprintf("No single send is named %q.", name))
+	case 1:
+		return ids[0], diags
+	default:
+		diags.AddError("Single send name is not unique",
+			fmt.Sprintf("%d single sends are named %q (%s); look it up by id instead.", len(ids), name, strings.Join(ids, ", ")))
+	}
+	return "", diags
+}
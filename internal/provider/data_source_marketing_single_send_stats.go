@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NOTE: Data source for the stats of one Marketing Campaigns single send.
+//
+// API Endpoints:
+//   - GET /v3/marketing/stats/singlesends/{id}?group_by&aggregated_by&start_date&end_date&timezone
+//
+// API Documentation:
+//   - Get Single Send Stats by ID: https://www.twilio.com/docs/sendgrid/api-reference/marketing-campaign-stats/get-single-send-stats-by-id
+
+var _ datasource.DataSource = (*MarketingSingleSendStatsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*MarketingSingleSendStatsDataSource)(nil)
+var _ datasource.DataSourceWithValidateConfig = (*MarketingSingleSendStatsDataSource)(nil)
+
+// MarketingSingleSendStatsDataSource implements sendgrid_marketing_single_send_stats.
+type MarketingSingleSendStatsDataSource struct {
+	client *Client
+}
+
+// NewMarketingSingleSendStatsDataSource returns a new instance of the data source.
+func NewMarketingSingleSendStatsDataSource() datasource.DataSource {
+	return &MarketingSingleSendStatsDataSource{}
+}
+
+type marketingSingleSendStatsModel struct {
+	ID           types.String `tfsdk:"id"`
+	GroupBy      types.List   `tfsdk:"group_by"`
+	AggregatedBy types.String `tfsdk:"aggregated_by"`
+	StartDate    types.String `tfsdk:"start_date"`
+	EndDate      types.String `tfsdk:"end_date"`
+	Timezone     types.String `tfsdk:"timezone"`
+
+	Totals  types.Object `tfsdk:"totals"`
+	Results types.List   `tfsdk:"results"`
+}
+
+func marketingSingleSendStatsResultType() types.ObjectType {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"ab_variation": types.StringType,
+		"ab_phase":     types.StringType,
+		"aggregation":  types.StringType,
+		"stats":        marketingStatsObjectType(),
+	}}
+}
+
+func (d *MarketingSingleSendStatsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_marketing_single_send_stats"
+}
+
+func (d *MarketingSingleSendStatsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*Client)
+	if !ok || c == nil {
+		resp.Diagnostics.AddError("Unexpected ProviderData", "Expected *Client")
+		return
+	}
+	d.client = c
+}
+
+func (d *MarketingSingleSendStatsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Read the statistics of one Marketing Campaigns single send via `/v3/marketing/stats/singlesends/{id}`, e.g. for post-campaign reporting from the configuration that scheduled the send.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Single send ID.",
+			},
+			"group_by": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Split `results` by `ab_variation` and/or `ab_phase` (for A/B tested sends).",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf("ab_variation", "ab_phase")),
+				},
+			},
+			"totals": marketingStatsSchema("Sum of the metrics over all `results`."),
+			"results": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Results as returned by the API, one per A/B variation, phase and/or day depending on the grouping.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ab_variation": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "A/B variation ID, when grouped by `ab_variation`.",
+						},
+						"ab_phase": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "A/B phase (`send`, `test`, or `all`), when grouped by `ab_phase`.",
+						},
+						"aggregation": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "`total` or the day (`YYYY-MM-DD`) of this result.",
+						},
+						"stats": marketingStatsSchema("Metrics of this result."),
+					},
+				},
+			},
+		},
+	}
+	for name, attr := range marketingStatsRangeAttributes() {
+		resp.Schema.Attributes[name] = attr
+	}
+}
+
+func (d *MarketingSingleSendStatsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	validateMarketingStatsRange(ctx, req.Config, &resp.Diagnostics)
+}
+
+func (d *MarketingSingleSendStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+
+	var config marketingSingleSendStatsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := map[string]string{}
+	if !config.GroupBy.IsNull() {
+		var groups []string
+		resp.Diagnostics.Append(config.GroupBy.ElementsAs(ctx, &groups, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		query["group_by"] = strings.Join(groups, ",")
+	}
+	for k, v := range map[string]types.String{
+		"aggregated_by": config.AggregatedBy,
+		"start_date":    config.StartDate,
+		"end_date":      config.EndDate,
+		"timezone":      config.Timezone,
+	} {
+		if !v.IsNull() {
+			query[k] = v.ValueString()
+		}
+	}
+
+	results, diags := d.client.marketingStatsResults(ctx, "/v3/marketing/stats/singlesends/"+url.PathEscape(config.ID.ValueString()), query)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var totals marketingStats
+	elems := make([]attr.Value, 0, len(results))
+	for _, res := range results {
+		totals.add(res.Stats)
+		stats, d := marketingStatsToObject(res.Stats)
+		resp.Diagnostics.Append(d...)
+		obj, d := types.ObjectValue(marketingSingleSendStatsResultType().AttrTypes, map[string]attr.Value{
+			"ab_variation": stringOrNull(res.ABVariation),
+			"ab_phase":     stringOrNull(res.ABPhase),
+			"aggregation":  types.StringValue(res.Aggregation),
+			"stats":        stats,
+		})
+		resp.Diagnostics.Append(d...)
+		elems = append(elems, obj)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	totalsObj, diags := marketingStatsToObject(totals)
+	resp.Diagnostics.Append(diags...)
+	list, diags := types.ListValue(marketingSingleSendStatsResultType(), elems)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.Totals = totalsObj
+	config.Results = list
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFindSingleSendByName(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/marketing/singlesends" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.URL.Query().Get("page_token") == "" {
+			_, _ = w.Write([]byte(`{"result":[{"id":"ss-1","name":"Launch"},{"id":"ss-2","name":"Newsletter"}],
+				"_metadata":{"next":"https://api.sendgrid.com/v3/marketing/singlesends?page_size=100&page_token=abc"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"result":[{"id":"ss-3","name":"Newsletter"},{"id":"ss-4","name":"Recap"}],"_metadata":{}}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, APIKey: "test-key"}
+	id, diags := c.findSingleSendByName(context.Background(), "Recap")
+	if diags.HasError() || id != "ss-4" {
+		t.Fatalf("Recap: id=%q diags=%v", id, diags)
+	}
+	if _, diags := c.findSingleSendByName(context.Background(), "Newsletter"); !diags.HasError() {
+		t.Fatal("expected an error for a duplicated name")
+	}
+	if _, diags := c.findSingleSendByName(context.Background(), "Missing"); !diags.HasError() {
+		t.Fatal("expected an error for an unknown name")
+	}
+}
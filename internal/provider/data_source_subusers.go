@@ -4,13 +4,19 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 	"net/url"
+	"regexp"
+	"sort"
 	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -33,13 +39,25 @@ type subusersDataSource struct {
 // GET /v3/subusers?username&limit&offset&region&include_region
 
 type subusersDataSourceModel struct {
-	Username      types.String `tfsdk:"username"`
-	Limit         types.Int64  `tfsdk:"limit"`
-	Offset        types.Int64  `tfsdk:"offset"`
-	Region        types.String `tfsdk:"region"`         // all|global|eu
-	IncludeRegion types.Bool   `tfsdk:"include_region"` // when true, API returns `region` per item
+	ID             types.String `tfsdk:"id"`
+	Username       types.String `tfsdk:"username"`
+	Limit          types.Int64  `tfsdk:"limit"`
+	Offset         types.Int64  `tfsdk:"offset"`
+	Region         types.String `tfsdk:"region"`          // all|global|eu
+	IncludeRegion  types.Bool   `tfsdk:"include_region"`  // when true, API returns `region` per item
+	FetchAll       types.Bool   `tfsdk:"fetch_all"`       // follow offset/limit until an empty page
+	IncludeDetails types.Bool   `tfsdk:"include_details"` // reputation, credits and IPs per subuser
 
-	Subusers types.List `tfsdk:"subusers"` // list of nested objects
+	// Client-side filters, applied after retrieval.
+	UsernamePrefix types.String `tfsdk:"username_prefix"`
+	UsernameRegex  types.String `tfsdk:"username_regex"`
+	Disabled       types.String `tfsdk:"disabled"` // true|false|any
+
+	SortBy    types.String `tfsdk:"sort_by"`    // username|id
+	SortOrder types.String `tfsdk:"sort_order"` // asc|desc
+
+	Subusers           types.List `tfsdk:"subusers"`             // list of nested objects
+	SubusersByUsername types.Map  `tfsdk:"subusers_by_username"` // same objects keyed by username
 }
 
 // Response item from /v3/subusers
@@ -61,6 +79,7 @@ func (d *subusersDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "List SendGrid subusers via `/v3/subusers`. Optionally filter by `username`, `limit`, `offset`, and `region`. If `include_region` is true, each element includes a `region`.",
 		Attributes: map[string]schema.Attribute{
+			"id": dataSourceIDAttribute(),
 			"username": schema.StringAttribute{
 				Optional:            true,
 				MarkdownDescription: "Filter by username (exact match).",
@@ -81,34 +100,117 @@ func (d *subusersDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Optional:            true,
 				MarkdownDescription: "If true, API includes `region` for each subuser in the response.",
 			},
+			"fetch_all": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("If true, keep requesting pages (starting at `offset`, `limit` per page, default %d) until the API returns an empty page, and return every subuser.", subuserListPageSize),
+			},
+			"include_details": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "If true, also fetch each subuser's `reputation`, `credits`, and assigned `ips`. Requests run concurrently, bounded by the provider `max_concurrency`.",
+			},
+			"username_prefix": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return subusers whose username starts with this prefix. Applied by the provider after retrieval; combine with `fetch_all` to search every subuser.",
+			},
+			"username_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return subusers whose username matches this regular expression (Go RE2 syntax). Applied by the provider after retrieval.",
+			},
+			"disabled": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return disabled (`true`) or active (`false`) subusers. `any` (the default) returns both. Applied by the provider after retrieval.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("true", "false", "any"),
+				},
+			},
+			"sort_by": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Sort `subusers` by `username` or `id` so list indexes are stable across runs. When omitted, the API order is kept.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("username", "id"),
+				},
+			},
+			"sort_order": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "`asc` (default) or `desc`. Only used with `sort_by`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("asc", "desc"),
+					stringvalidator.AlsoRequires(path.MatchRoot("sort_by")),
+				},
+			},
 			"subusers": schema.ListNestedAttribute{
 				Computed:            true,
 				MarkdownDescription: "List of subusers.",
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.Int64Attribute{
-							Computed:            true,
-							MarkdownDescription: "Subuser ID.",
-						},
-						"username": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "Subuser username.",
-						},
-						"email": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "Subuser email.",
-						},
-						"disabled": schema.BoolAttribute{
-							Computed:            true,
-							MarkdownDescription: "Whether the subuser is disabled.",
-						},
-						"region": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "Region string when requested with include_region=true (may be empty otherwise).",
-						},
+				NestedObject:        subuserNestedObject(),
+			},
+			"subusers_by_username": schema.MapNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The elements of `subusers` keyed by username, e.g. for `for_each`.",
+				NestedObject:        subuserNestedObject(),
+			},
+		},
+	}
+}
+
+// subuserNestedObject is the schema of a subusers element.
+func subuserNestedObject() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Subuser ID.",
+			},
+			"username": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Subuser username.",
+			},
+			"email": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Subuser email.",
+			},
+			"disabled": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the subuser is disabled.",
+			},
+			"region": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Region string when requested with include_region=true (may be empty otherwise).",
+			},
+			"reputation": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Sender reputation (0-100). Only set with `include_details = true`.",
+			},
+			"credits": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Credit allocation. Only set with `include_details = true`.",
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "`unlimited`, `recurring`, or `nonrecurring`.",
+					},
+					"reset_frequency": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "`daily`, `weekly`, or `monthly` for recurring credits.",
+					},
+					"remain": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Credits remaining.",
+					},
+					"total": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Total credits allocated.",
+					},
+					"used": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Credits used.",
 					},
 				},
 			},
+			"ips": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "IP addresses assigned to the subuser. Only set with `include_details = true`.",
+			},
 		},
 	}
 }
@@ -144,15 +246,7 @@ func (d *subusersDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	// Build URL: {base}/v3/subusers
-	u, err := url.Parse(d.client.BaseURL)
-	if err != nil {
-		resp.Diagnostics.AddError("Invalid base URL", err.Error())
-		return
-	}
-	u.Path = "/v3/subusers"
-	q := u.Query()
-
+	q := url.Values{}
 	if !config.Username.IsNull() && !config.Username.IsUnknown() {
 		q.Set("username", config.Username.ValueString())
 	}
@@ -168,53 +262,95 @@ func (d *subusersDataSource) Read(ctx context.Context, req datasource.ReadReques
 	if !config.IncludeRegion.IsNull() && !config.IncludeRegion.IsUnknown() {
 		q.Set("include_region", fmt.Sprintf("%t", config.IncludeRegion.ValueBool()))
 	}
-	u.RawQuery = q.Encode()
-
-	tflog.Debug(ctx, "GET /v3/subusers", map[string]any{"url": u.String()})
-
-	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Building request failed", err.Error())
-		return
-	}
-	httpReq.Header.Set("Authorization", "Bearer "+d.client.APIKey)
-	httpReq.Header.Set("Content-Type", "application/json")
-	httpReq.Header.Set("Accept", "application/json")
-
-	hc := &http.Client{}
-	httpResp, err := hc.Do(httpReq)
-	if err != nil {
-		resp.Diagnostics.AddError("Request failed", err.Error())
-		return
-	}
-	defer func() {
-		_ = httpResp.Body.Close()
-	}()
 
-	if httpResp.StatusCode != http.StatusOK {
-		resp.Diagnostics.AddError("Unexpected status code", fmt.Sprintf("GET /v3/subusers returned %d", httpResp.StatusCode))
-		return
+	var items []subuserAPI
+	if config.FetchAll.ValueBool() {
+		// Page explicitly so the API default page size cannot truncate results.
+		pageSize := int64(subuserListPageSize)
+		if !config.Limit.IsNull() && !config.Limit.IsUnknown() {
+			pageSize = config.Limit.ValueInt64()
+		}
+		offset := config.Offset.ValueInt64()
+		q.Set("limit", strconv.FormatInt(pageSize, 10))
+		for {
+			q.Set("offset", strconv.FormatInt(offset, 10))
+			page, diags := d.fetchPage(ctx, q)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			if len(page) == 0 {
+				break
+			}
+			items = append(items, page...)
+			offset += int64(len(page))
+		}
+	} else {
+		page, diags := d.fetchPage(ctx, q)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		items = page
 	}
 
-	var items []subuserAPI
-	if err := json.NewDecoder(httpResp.Body).Decode(&items); err != nil {
-		resp.Diagnostics.AddError("Decoding response failed", err.Error())
-		return
+	var usernameRE *regexp.Regexp
+	if !config.UsernameRegex.IsNull() && !config.UsernameRegex.IsUnknown() {
+		re, err := regexp.Compile(config.UsernameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("username_regex"), "Invalid username_regex", err.Error())
+			return
+		}
+		usernameRE = re
 	}
+	items = filterSubusersByUsername(items, config.UsernamePrefix.ValueString(), usernameRE)
+	items = filterSubusersByDisabled(items, config.Disabled.ValueString())
+	sortSubusers(items, config.SortBy.ValueString(), config.SortOrder.ValueString() == "desc")
 
 	// Map to state list
 	// Build element type definition
 	elemAttrTypes := map[string]attr.Type{
-		"id":       types.Int64Type,
-		"username": types.StringType,
-		"email":    types.StringType,
-		"disabled": types.BoolType,
-		"region":   types.StringType,
+		"id":         types.Int64Type,
+		"username":   types.StringType,
+		"email":      types.StringType,
+		"disabled":   types.BoolType,
+		"region":     types.StringType,
+		"reputation": types.Float64Type,
+		"credits":    subuserCreditsObjectType(),
+		"ips":        types.ListType{ElemType: types.StringType},
+	}
+
+	var details []subuserDetails
+	if config.IncludeDetails.ValueBool() {
+		var detailDiags diag.Diagnostics
+		details, detailDiags = d.client.fetchSubuserDetails(ctx, items)
+		resp.Diagnostics.Append(detailDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	elemType := types.ObjectType{AttrTypes: elemAttrTypes}
 	elems := make([]types.Object, 0, len(items))
-	for _, it := range items {
+	for i, it := range items {
+		reputation := types.Float64Null()
+		credits := types.ObjectNull(subuserCreditsObjectType().AttrTypes)
+		ips := types.ListNull(types.StringType)
+		if details != nil {
+			var d diag.Diagnostics
+			reputation = types.Float64Value(details[i].Reputation)
+			credits, d = types.ObjectValueFrom(ctx, subuserCreditsObjectType().AttrTypes, subuserCreditsModel{
+				Type:           types.StringValue(details[i].Credits.Type),
+				ResetFrequency: types.StringValue(details[i].Credits.ResetFrequency),
+				Remain:         types.Int64Value(details[i].Credits.Remain),
+				Total:          types.Int64Value(details[i].Credits.Total),
+				Used:           types.Int64Value(details[i].Credits.Used),
+			})
+			resp.Diagnostics.Append(d...)
+			ips, d = types.ListValueFrom(ctx, types.StringType, details[i].IPs)
+			resp.Diagnostics.Append(d...)
+		}
+
 		// ensure region is empty when not provided
 		obj, objDiags := types.ObjectValue(elemAttrTypes, map[string]attr.Value{
 			"id":       types.Int64Value(it.ID),
@@ -227,6 +363,9 @@ func (d *subusersDataSource) Read(ctx context.Context, req datasource.ReadReques
 				}
 				return types.StringValue(it.Region)
 			}(),
+			"reputation": reputation,
+			"credits":    credits,
+			"ips":        ips,
 		})
 		resp.Diagnostics.Append(objDiags...)
 		if resp.Diagnostics.HasError() {
@@ -241,14 +380,155 @@ func (d *subusersDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
+	byUsername := make(map[string]types.Object, len(items))
+	for i, it := range items {
+		byUsername[it.Username] = elems[i]
+	}
+	mapVal, mapDiags := types.MapValueFrom(ctx, elemType, byUsername)
+	resp.Diagnostics.Append(mapDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	state := subusersDataSourceModel{
-		Username:      config.Username,
-		Limit:         config.Limit,
-		Offset:        config.Offset,
-		Region:        config.Region,
-		IncludeRegion: config.IncludeRegion,
-		Subusers:      listVal,
+		ID: types.StringValue(dataSourceID("subusers", map[string]attr.Value{
+			"username":        config.Username,
+			"limit":           config.Limit,
+			"offset":          config.Offset,
+			"region":          config.Region,
+			"include_region":  config.IncludeRegion,
+			"fetch_all":       config.FetchAll,
+			"include_details": config.IncludeDetails,
+			"username_prefix": config.UsernamePrefix,
+			"username_regex":  config.UsernameRegex,
+			"disabled":        config.Disabled,
+			"sort_by":         config.SortBy,
+			"sort_order":      config.SortOrder,
+		})),
+		Username:       config.Username,
+		Limit:          config.Limit,
+		Offset:         config.Offset,
+		Region:         config.Region,
+		IncludeRegion:  config.IncludeRegion,
+		FetchAll:       config.FetchAll,
+		IncludeDetails: config.IncludeDetails,
+
+		UsernamePrefix: config.UsernamePrefix,
+		UsernameRegex:  config.UsernameRegex,
+		Disabled:       config.Disabled,
+		SortBy:         config.SortBy,
+		SortOrder:      config.SortOrder,
+		Subusers:       listVal,
+
+		SubusersByUsername: mapVal,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
+
+// filterSubusersByUsername keeps subusers whose username has prefix (when not
+// empty) and matches re (when not nil).
+func filterSubusersByUsername(items []subuserAPI, prefix string, re *regexp.Regexp) []subuserAPI {
+	out := make([]subuserAPI, 0, len(items))
+	for _, it := range items {
+		if !strings.HasPrefix(it.Username, prefix) {
+			continue
+		}
+		if re != nil && !re.MatchString(it.Username) {
+			continue
+		}
+		out = append(out, it)
+	}
+	return out
+}
+
+// filterSubusersByDisabled keeps only disabled subusers for "true" and only
+// active ones for "false"; any other value keeps every subuser.
+func filterSubusersByDisabled(items []subuserAPI, disabled string) []subuserAPI {
+	var want bool
+	switch disabled {
+	case "true":
+		want = true
+	case "false":
+		want = false
+	default:
+		return items
+	}
+	out := make([]subuserAPI, 0, len(items))
+	for _, it := range items {
+		if it.Disabled == want {
+			out = append(out, it)
+		}
+	}
+	return out
+}
+
+// sortSubusers sorts items in place by "username" or "id"; any other key
+// leaves the API order untouched.
+func sortSubusers(items []subuserAPI, by string, desc bool) {
+	var less func(a, b subuserAPI) bool
+	switch by {
+	case "username":
+		less = func(a, b subuserAPI) bool { return a.Username < b.Username }
+	case "id":
+		less = func(a, b subuserAPI) bool { return a.ID < b.ID }
+	default:
+		return
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		if desc {
+			return less(items[j], items[i])
+		}
+		return less(items[i], items[j])
+	})
+}
+
+// fetchPage performs a single GET /v3/subusers with the given query through
+// the client's GET cache (and so sendgrid-go, like every other call in the
+// provider), so the configured base URL (e.g., EU) and client behavior apply
+// here too.
+func (d *subusersDataSource) fetchPage(ctx context.Context, q url.Values) ([]subuserAPI, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tflog.Debug(ctx, "GET /v3/subusers", map[string]any{"query": q.Encode()})
+	query := make(map[string]string, len(q))
+	for k := range q {
+		query[k] = q.Get(k)
+	}
+	sgResp, err := d.client.cachedGet(ctx, "/v3/subusers", query)
+	if err != nil {
+		diags.AddError("SendGrid API error (subusers)", err.Error())
+		return nil, diags
+	}
+	diags.Append(deprecationWarnings("GET", "/v3/subusers", sgResp.Headers)...)
+	if sgResp.StatusCode >= 300 {
+		diags.AddError("List subusers failed",
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+		return nil, diags
+	}
+
+	var items []subuserAPI
+	if err := json.Unmarshal([]byte(sgResp.Body), &items); err != nil {
+		diags.AddError("Parse error (subusers)", fmt.Sprintf("unable to parse body: %v", err))
+		return nil, diags
+	}
+	return items, diags
+}
+
+type subuserCreditsModel struct {
+	Type           types.String `tfsdk:"type"`
+	ResetFrequency types.String `tfsdk:"reset_frequency"`
+	Remain         types.Int64  `tfsdk:"remain"`
+	Total          types.Int64  `tfsdk:"total"`
+	Used           types.Int64  `tfsdk:"used"`
+}
+
+func subuserCreditsObjectType() types.ObjectType {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"type":            types.StringType,
+		"reset_frequency": types.StringType,
+		"remain":          types.Int64Type,
+		"total":           types.Int64Type,
+		"used":            types.Int64Type,
+	}}
+}
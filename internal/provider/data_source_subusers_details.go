@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// NOTE: Per-subuser details for data.sendgrid_subusers with include_details.
+//
+// API Endpoints:
+//   - Reputation: GET /v3/subusers/reputations?usernames={username}
+//   - Credits:    GET /v3/subusers/{subuser_name}/credits
+//   - IPs:        GET /v3/ips?subuser={username}
+//
+// API Documentation:
+//   - Subuser Reputations: https://www.twilio.com/docs/sendgrid/api-reference/subusers-api/retrieve-subuser-reputations
+//   - Subuser Credits:     https://www.twilio.com/docs/sendgrid/api-reference/subusers-api/get-subuser-credits
+//   - Retrieve all IPs:    https://www.twilio.com/docs/sendgrid/api-reference/ip-address/retrieve-all-ip-addresses
+
+// subuserDetails holds the extra data embedded per subuser element.
+type subuserDetails struct {
+	Reputation float64
+	Credits    subuserCredits
+	IPs        []string
+}
+
+type subuserCredits struct {
+	Type           string `json:"type"`
+	ResetFrequency string `json:"reset_frequency"`
+	Remain         int64  `json:"remain"`
+	Total          int64  `json:"total"`
+	Used           int64  `json:"used"`
+}
+
+// fetchSubuserDetails loads details for every item, running at most
+// c.maxConcurrency() subusers at a time. The first failure is reported.
+func (c *Client) fetchSubuserDetails(ctx context.Context, items []subuserAPI) ([]subuserDetails, diag.Diagnostics) {
+	out := make([]subuserDetails, len(items))
+	errs := make([]diag.Diagnostics, len(items))
+
+	sem := make(chan struct{}, c.maxConcurrency())
+	var wg sync.WaitGroup
+	for i, it := range items {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, username string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			out[i], errs[i] = c.subuserDetails(ctx, username)
+		}(i, it.Username)
+	}
+	wg.Wait()
+
+	var diags diag.Diagnostics
+	for _, d := range errs {
+		if d.HasError() {
+			diags.Append(d...)
+			return nil, diags
+		}
+	}
+	return out, diags
+}
+
+// subuserDetails reads reputation, credits and assigned IPs of one subuser.
+func (c *Client) subuserDetails(ctx context.Context, username string) (subuserDetails, diag.Diagnostics) {
+	var details subuserDetails
+	var diags diag.Diagnostics
+
+	var reputations []struct {
+		Username   string  `json:"username"`
+		Reputation float64 `json:"reputation"`
+	}
+	if !c.getJSON(ctx, "/v3/subusers/reputations", map[string]string{"usernames": username}, &reputations, &diags) {
+		return details, diags
+	}
+	for _, r := range reputations {
+		if r.Username == username {
+			details.Reputation = r.Reputation
+		}
+	}
+
+	if !c.getJSON(ctx, "/v3/subusers/"+url.PathEscape(username)+"/credits", nil, &details.Credits, &diags) {
+		return details, diags
+	}
+
+	var ips []struct {
+		IP string `json:"ip"`
+	}
+	if !c.getJSON(ctx, "/v3/ips", map[string]string{"subuser": username}, &ips, &diags) {
+		return details, diags
+	}
+	details.IPs = make([]string, 0, len(ips))
+	for _, ip := range ips {
+		details.IPs = append(details.IPs, ip.IP)
+	}
+	return details, diags
+}
+
+// getJSON performs a GET and decodes the JSON body into out. It returns false
+// (with an error in diags) on transport, status or parse failures.
+func (c *Client) getJSON(ctx context.Context, path string, query map[string]string, out any, diags *diag.Diagnostics) bool {
+	tflog.Debug(ctx, "GET "+path, map[string]any{"query": query})
+	sgResp, err := c.cachedGet(ctx, path, query)
+	if err != nil {
+		diags.AddError("SendGrid API error", fmt.Sprintf("GET %s: %v", path, err))
+		return false
+	}
+	diags.Append(deprecationWarnings("GET", path, sgResp.Headers)...)
+	if sgResp.StatusCode >= 300 {
+		diags.AddError("SendGrid API error",
+			fmt.Sprintf("GET %s: status=%d body=%s", path, sgResp.StatusCode, sgResp.Body))
+		return false
+	}
+	if err := json.Unmarshal([]byte(sgResp.Body), out); err != nil {
+		diags.AddError("Parse error", fmt.Sprintf("GET %s: unable to parse body: %v", path, err))
+		return false
+	}
+	return true
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFetchSubuserDetails_BoundedConcurrency(t *testing.T) {
+	var inFlight, peak int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		switch {
+		case r.URL.Path == "/v3/subusers/reputations":
+			name := r.URL.Query().Get("usernames")
+			_ = json.NewEncoder(w).Encode([]map[string]any{{"username": name, "reputation": 99.5}})
+		case strings.HasSuffix(r.URL.Path, "/credits"):
+			_ = json.NewEncoder(w).Encode(map[string]any{"type": "recurring", "reset_frequency": "monthly", "total": 100, "remain": 60, "used": 40})
+		case r.URL.Path == "/v3/ips":
+			_ = json.NewEncoder(w).Encode([]map[string]any{{"ip": "192.0.2." + r.URL.Query().Get("subuser")[3:]}})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	items := []subuserAPI{{Username: "sub1"}, {Username: "sub2"}, {Username: "sub3"}, {Username: "sub4"}, {Username: "sub5"}}
+	c := &Client{BaseURL: srv.URL, APIKey: "test-key", MaxConcurrency: 2}
+	got, diags := c.fetchSubuserDetails(context.Background(), items)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if peak > 2 {
+		t.Fatalf("expected at most 2 concurrent requests, saw %d", peak)
+	}
+	if got[2].Reputation != 99.5 || got[2].Credits.Remain != 60 || got[2].IPs[0] != "192.0.2.3" {
+		t.Fatalf("unexpected details: %+v", got[2])
+	}
+}
@@ -2,7 +2,6 @@ package provider_test
 
 import (
 	"fmt"
-	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -42,10 +41,7 @@ func TestAccDataSubusers_basic(t *testing.T) {
 }
 
 func TestAccDataSubusers_filterByUsername(t *testing.T) {
-	username := os.Getenv("TEST_SUBUSER_USERNAME")
-	if username == "" {
-		t.Skip("TEST_SUBUSER_USERNAME not set; skipping username filter test")
-	}
+	username := testacc.Subuser(t).Username
 
 	cfg := fmt.Sprintf(`
         data "sendgrid_subusers" "t" {
@@ -71,3 +67,33 @@ func TestAccDataSubusers_filterByUsername(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDataSubusers_fetchAll(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testacc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testacc.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// limit = 1 forces one request per subuser, exercising the paging loop.
+				Config: `
+                    data "sendgrid_subusers" "page" {
+                      limit = 1
+                    }
+
+                    data "sendgrid_subusers" "all" {
+                      limit     = 1
+                      fetch_all = true
+                    }
+                `,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sendgrid_subusers.page", "subusers.#", "1"),
+					checkListLenGE("data.sendgrid_subusers.all", "subusers", 1, t),
+					logAttr("data.sendgrid_subusers.all", "subusers.#", t),
+					resource.TestCheckResourceAttrPair(
+						"data.sendgrid_subusers.all", "subusers.#",
+						"data.sendgrid_subusers.all", "subusers_by_username.%"),
+				),
+			},
+		},
+	})
+}
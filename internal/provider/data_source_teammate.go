@@ -4,10 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/sendgrid/sendgrid-go"
 )
@@ -27,6 +29,7 @@ func NewTeammateDataSource() datasource.DataSource {
 
 // teammateModel maps data source schema data.
 type teammateModel struct {
+	ID         types.String `tfsdk:"id"`
 	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
 	Username   types.String `tfsdk:"username"`
 	Email      types.String `tfsdk:"email"`
@@ -44,6 +47,14 @@ type teammateModel struct {
 	State      types.String `tfsdk:"state"`
 	Zip        types.String `tfsdk:"zip"`
 	Country    types.String `tfsdk:"country"`
+
+	Status               types.String `tfsdk:"status"`
+	InviteToken          types.String `tfsdk:"invite_token"`
+	InviteExpirationDate types.Int64  `tfsdk:"invite_expiration_date"`
+
+	IncludeSubuserAccess       types.Bool           `tfsdk:"include_subuser_access"`
+	HasRestrictedSubuserAccess types.Bool           `tfsdk:"has_restricted_subuser_access"`
+	SubuserAccess              []subuserAccessModel `tfsdk:"subuser_access"`
 }
 
 // Metadata sets the data source type name.
@@ -54,15 +65,28 @@ func (d *TeammateDataSource) Metadata(_ context.Context, req datasource.Metadata
 // Schema defines the data source schema.
 func (d *TeammateDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Lookup a SendGrid teammate by username and return details.",
+		MarkdownDescription: "Lookup a SendGrid teammate by username and return details. When no teammate exists under `username` but a pending invitation was sent to that email address, the invitation is returned with `status = \"pending\"` instead of an error.",
 		Attributes: map[string]schema.Attribute{
+			"id": dataSourceIDAttribute(),
 			"on_behalf_of": schema.StringAttribute{
 				Optional:            true,
 				MarkdownDescription: "Parent account header to impersonate a Subuser: sets the HTTP header `on-behalf-of` to the given subuser username.",
 			},
 			"username": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "Teammate username.",
+				MarkdownDescription: "Teammate username. For a pending invitation, the invited email address.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Status of an existing teammate as returned by SendGrid (e.g. `active`, or `pending` for an SSO teammate that has not signed in yet; `active` when the API returns none), or `pending` when only an unaccepted invitation was found. A pending invitation only sets `email`, `is_admin`, `scopes`, `invite_token` and `invite_expiration_date`.",
+			},
+			"invite_token": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Token of the pending invitation, as used to resend or delete it. Only set when `status = \"pending\"`.",
+			},
+			"invite_expiration_date": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Expiration of the pending invitation (Unix seconds). Only set when `status = \"pending\"`.",
 			},
 			"email": schema.StringAttribute{
 				Computed:            true,
@@ -125,6 +149,19 @@ func (d *TeammateDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Computed:            true,
 				MarkdownDescription: "List of granted scopes for the teammate.",
 			},
+			"include_subuser_access": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Set true to also fetch every page of the teammate's subuser access into `has_restricted_subuser_access` and `subuser_access`.",
+			},
+			"has_restricted_subuser_access": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the teammate has restricted subuser access. Only set when `include_subuser_access = true`.",
+			},
+			"subuser_access": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Subuser access entries, across all pages. Only set when `include_subuser_access = true`.",
+				NestedObject:        subuserAccessNestedObject(),
+			},
 		},
 	}
 }
@@ -173,6 +210,10 @@ func (d *TeammateDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	username := data.Username.ValueString()
+	data.ID = types.StringValue(dataSourceID("teammate", map[string]attr.Value{
+		"username":     data.Username,
+		"on_behalf_of": data.OnBehalfOf,
+	}))
 
 	// Build request using sendgrid-go with provider-configured BaseURL (EU/US support).
 	request := sendgrid.GetRequest(d.client.APIKey, "/v3/teammates/"+username, d.client.BaseURL)
@@ -190,6 +231,25 @@ func (d *TeammateDataSource) Read(ctx context.Context, req datasource.ReadReques
 		resp.Diagnostics.AddError("SendGrid API request failed", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(deprecationWarnings(string(request.Method), request.BaseURL, sgResp.Headers)...)
+
+	// Invited users are not teammates until they accept; fall back to the
+	// pending invitations before reporting the 404.
+	if sgResp.StatusCode == http.StatusNotFound {
+		inv, found, invDiags := d.client.findPendingTeammateInvite(ctx, username, onBehalf)
+		resp.Diagnostics.Append(invDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if found {
+			d.setPendingInvite(&data, inv, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
 
 	if sgResp.StatusCode >= 300 {
 		resp.Diagnostics.AddError(
@@ -216,6 +276,7 @@ func (d *TeammateDataSource) Read(ctx context.Context, req datasource.ReadReques
 		State     string   `json:"state"`
 		Zip       string   `json:"zip"`
 		Country   string   `json:"country"`
+		Status    string   `json:"status"`
 	}
 	if err := json.Unmarshal([]byte(sgResp.Body), &payload); err != nil {
 		resp.Diagnostics.AddError("Failed to parse API response", fmt.Sprintf("Unable to parse JSON body: %v", err))
@@ -241,21 +302,67 @@ func (d *TeammateDataSource) Read(ctx context.Context, req datasource.ReadReques
 	data.Zip = types.StringValue(payload.Zip)
 	data.Country = types.StringValue(payload.Country)
 	data.IsAdmin = types.BoolValue(payload.IsAdmin)
-
-	// Convert scopes slice to Terraform types.Set
-	scopeVals := make([]attr.Value, 0, len(payload.Scopes))
-	for _, s := range payload.Scopes {
-		scopeVals = append(scopeVals, types.StringValue(s))
+	// Older responses carry no status; a teammate that can be read is active.
+	data.Status = types.StringValue("active")
+	if payload.Status != "" {
+		data.Status = types.StringValue(payload.Status)
 	}
-	setVal, diagSet := types.SetValue(types.StringType, scopeVals)
-	resp.Diagnostics.Append(diagSet...)
+	data.InviteToken = types.StringNull()
+	data.InviteExpirationDate = types.Int64Null()
+
+	data.Scopes = teammateScopesSet(payload.Scopes, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	data.Scopes = setVal
+
+	data.HasRestrictedSubuserAccess = types.BoolNull()
+	data.SubuserAccess = nil
+	if data.IncludeSubuserAccess.ValueBool() {
+		hasRestricted, entries, saDiags := d.client.teammateSubuserAccess(ctx, username, onBehalf, defaultSubuserAccessPageSize)
+		resp.Diagnostics.Append(saDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.HasRestrictedSubuserAccess = types.BoolValue(hasRestricted)
+		data.SubuserAccess = subuserAccessModelsFromEntries(entries, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	if diags := resp.State.Set(ctx, &data); diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
 	}
 }
+
+// setPendingInvite fills data from a pending invitation. Profile attributes
+// are unknown until the invitation is accepted and are left null.
+func (d *TeammateDataSource) setPendingInvite(data *teammateModel, inv pendingTeammateInvite, diags *diag.Diagnostics) {
+	data.Status = types.StringValue("pending")
+	data.Email = types.StringValue(inv.Email)
+	data.IsAdmin = types.BoolValue(inv.IsAdmin)
+	data.InviteToken = types.StringValue(inv.Token)
+	data.InviteExpirationDate = types.Int64Value(inv.ExpirationDate)
+	data.Scopes = teammateScopesSet(inv.Scopes, diags)
+
+	for _, s := range []*types.String{
+		&data.FirstName, &data.LastName, &data.UserType, &data.Phone, &data.Website, &data.Company,
+		&data.Address, &data.Address2, &data.City, &data.State, &data.Zip, &data.Country,
+	} {
+		*s = types.StringNull()
+	}
+	data.HasRestrictedSubuserAccess = types.BoolNull()
+	data.SubuserAccess = nil
+}
+
+// teammateScopesSet converts a scopes slice to a Terraform set of strings.
+func teammateScopesSet(scopes []string, diags *diag.Diagnostics) types.Set {
+	scopeVals := make([]attr.Value, 0, len(scopes))
+	for _, s := range scopes {
+		scopeVals = append(scopeVals, types.StringValue(s))
+	}
+	setVal, d := types.SetValue(types.StringType, scopeVals)
+	diags.Append(d...)
+	return setVal
+}
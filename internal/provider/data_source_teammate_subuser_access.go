@@ -6,9 +6,14 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/sendgrid/sendgrid-go"
 )
@@ -28,15 +33,22 @@ func NewTeammateSubuserAccessDataSource() datasource.DataSource {
 
 // teammateSubuserAccessModel maps data source schema data.
 type teammateSubuserAccessModel struct {
+	ID                         types.String         `tfsdk:"id"`
 	TeammateName               types.String         `tfsdk:"teammate_name"`
 	Limit                      types.Int64          `tfsdk:"limit"`
 	AfterSubuserID             types.Int64          `tfsdk:"after_subuser_id"`
+	FetchAll                   types.Bool           `tfsdk:"fetch_all"`
+	PermissionType             types.String         `tfsdk:"permission_type"`
+	ExcludeDisabled            types.Bool           `tfsdk:"exclude_disabled"`
 	Username                   types.String         `tfsdk:"username"`
 	HasRestrictedSubuserAccess types.Bool           `tfsdk:"has_restricted_subuser_access"`
 	SubuserAccess              []subuserAccessModel `tfsdk:"subuser_access"`
-	NextLimit                  types.Int64          `tfsdk:"next_limit"`
-	NextAfterSubuserID         types.Int64          `tfsdk:"next_after_subuser_id"`
-	NextUsername               types.String         `tfsdk:"next_username"`
+
+	SubuserAccessByID       map[string]subuserAccessModel `tfsdk:"subuser_access_by_id"`
+	SubuserAccessByUsername map[string]subuserAccessModel `tfsdk:"subuser_access_by_username"`
+	NextLimit               types.Int64                   `tfsdk:"next_limit"`
+	NextAfterSubuserID      types.Int64                   `tfsdk:"next_after_subuser_id"`
+	NextUsername            types.String                  `tfsdk:"next_username"`
 }
 
 type subuserAccessModel struct {
@@ -58,6 +70,7 @@ func (d *TeammateSubuserAccessDataSource) Schema(_ context.Context, _ datasource
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Retrieve subuser access details for a SendGrid teammate.",
 		Attributes: map[string]schema.Attribute{
+			"id": dataSourceIDAttribute(),
 			"teammate_name": schema.StringAttribute{
 				MarkdownDescription: "Teammate username.",
 				Required:            true,
@@ -70,10 +83,28 @@ func (d *TeammateSubuserAccessDataSource) Schema(_ context.Context, _ datasource
 				MarkdownDescription: "Pagination cursor: fetch results after this subuser ID.",
 				Optional:            true,
 			},
+			"fetch_all": schema.BoolAttribute{
+				MarkdownDescription: "Set true to follow `after_subuser_id` until the last page and return the complete list in `subuser_access`. `limit` then sets the page size. Cannot be combined with `after_subuser_id` or `username`.",
+				Optional:            true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("after_subuser_id"), path.MatchRoot("username")),
+				},
+			},
 			"username": schema.StringAttribute{
 				MarkdownDescription: "Filter results by subuser username (query parameter: `username`).",
 				Optional:            true,
 			},
+			"permission_type": schema.StringAttribute{
+				MarkdownDescription: "Only return entries with this permission type (`admin` or `restricted`). Applied by the provider after fetching.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("admin", "restricted"),
+				},
+			},
+			"exclude_disabled": schema.BoolAttribute{
+				MarkdownDescription: "Set true to drop entries for disabled subusers. Applied by the provider after fetching.",
+				Optional:            true,
+			},
 			"has_restricted_subuser_access": schema.BoolAttribute{
 				MarkdownDescription: "Whether the teammate has restricted subuser access.",
 				Computed:            true,
@@ -81,35 +112,17 @@ func (d *TeammateSubuserAccessDataSource) Schema(_ context.Context, _ datasource
 			"subuser_access": schema.ListNestedAttribute{
 				MarkdownDescription: "List of subuser access entries.",
 				Computed:            true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.Int64Attribute{
-							MarkdownDescription: "Subuser access ID.",
-							Computed:            true,
-						},
-						"username": schema.StringAttribute{
-							MarkdownDescription: "Subuser username.",
-							Computed:            true,
-						},
-						"email": schema.StringAttribute{
-							MarkdownDescription: "Subuser email address.",
-							Computed:            true,
-						},
-						"disabled": schema.BoolAttribute{
-							MarkdownDescription: "Whether the subuser is disabled.",
-							Computed:            true,
-						},
-						"permission_type": schema.StringAttribute{
-							MarkdownDescription: "Permission type: 'admin' or 'restricted'.",
-							Computed:            true,
-						},
-						"scopes": schema.SetAttribute{
-							ElementType:         types.StringType,
-							MarkdownDescription: "List of granted scopes for the subuser.",
-							Computed:            true,
-						},
-					},
-				},
+				NestedObject:        subuserAccessNestedObject(),
+			},
+			"subuser_access_by_id": schema.MapNestedAttribute{
+				MarkdownDescription: "The entries of `subuser_access` keyed by subuser ID, for use with `for_each`.",
+				Computed:            true,
+				NestedObject:        subuserAccessNestedObject(),
+			},
+			"subuser_access_by_username": schema.MapNestedAttribute{
+				MarkdownDescription: "The entries of `subuser_access` keyed by subuser username, for use with `for_each`.",
+				Computed:            true,
+				NestedObject:        subuserAccessNestedObject(),
 			},
 			"next_limit": schema.Int64Attribute{
 				MarkdownDescription: "Next page limit parameter for pagination.",
@@ -127,6 +140,78 @@ func (d *TeammateSubuserAccessDataSource) Schema(_ context.Context, _ datasource
 	}
 }
 
+// subuserAccessNestedObject is the schema of a subuser_access list element,
+// shared with data.sendgrid_teammate.
+func subuserAccessNestedObject() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Subuser access ID.",
+				Computed:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Subuser username.",
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Subuser email address.",
+				Computed:            true,
+			},
+			"disabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the subuser is disabled.",
+				Computed:            true,
+			},
+			"permission_type": schema.StringAttribute{
+				MarkdownDescription: "Permission type: 'admin' or 'restricted'.",
+				Computed:            true,
+			},
+			"scopes": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "List of granted scopes for the subuser.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// filterSubuserAccess keeps the entries matching permissionType (when not
+// empty) and, with excludeDisabled, only enabled subusers.
+func filterSubuserAccess(entries []subuserAccessEntry, permissionType string, excludeDisabled bool) []subuserAccessEntry {
+	out := make([]subuserAccessEntry, 0, len(entries))
+	for _, e := range entries {
+		if permissionType != "" && e.PermissionType != permissionType {
+			continue
+		}
+		if excludeDisabled && e.Disabled {
+			continue
+		}
+		out = append(out, e)
+	}
+	return out
+}
+
+// subuserAccessModelsFromEntries converts API entries to data source models.
+func subuserAccessModelsFromEntries(entries []subuserAccessEntry, diags *diag.Diagnostics) []subuserAccessModel {
+	out := make([]subuserAccessModel, 0, len(entries))
+	for _, e := range entries {
+		scopeVals := make([]attr.Value, 0, len(e.Scopes))
+		for _, s := range e.Scopes {
+			scopeVals = append(scopeVals, types.StringValue(s))
+		}
+		setVal, d := types.SetValue(types.StringType, scopeVals)
+		diags.Append(d...)
+		out = append(out, subuserAccessModel{
+			ID:             types.Int64Value(e.ID),
+			Username:       types.StringValue(e.Username),
+			Email:          types.StringValue(e.Email),
+			Disabled:       types.BoolValue(e.Disabled),
+			PermissionType: types.StringValue(e.PermissionType),
+			Scopes:         setVal,
+		})
+	}
+	return out
+}
+
 // Configure receives provider configured client.
 func (d *TeammateSubuserAccessDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -166,97 +251,108 @@ func (d *TeammateSubuserAccessDataSource) Read(ctx context.Context, req datasour
 	}
 
 	teammateName := state.TeammateName.ValueString()
+	fetchAll := state.FetchAll.ValueBool()
 
-	// Build request using sendgrid-go with provider-configured BaseURL (EU/US support).
-	path := "/v3/teammates/" + teammateName + "/subuser_access"
-	request := sendgrid.GetRequest(d.client.APIKey, path, d.client.BaseURL)
-	request.Method = "GET"
-
-	// Add query parameters if provided
-	queryParams := make(map[string]string)
-	if !state.Limit.IsNull() && !state.Limit.IsUnknown() {
-		queryParams["limit"] = strconv.FormatInt(state.Limit.ValueInt64(), 10)
-	}
+	var afterID int64
 	if !state.AfterSubuserID.IsNull() && !state.AfterSubuserID.IsUnknown() {
-		queryParams["after_subuser_id"] = strconv.FormatInt(state.AfterSubuserID.ValueInt64(), 10)
-	}
-	if !state.Username.IsNull() && !state.Username.IsUnknown() {
-		queryParams["username"] = state.Username.ValueString()
+		afterID = state.AfterSubuserID.ValueInt64()
 	}
-	if len(queryParams) > 0 {
-		q := request.QueryParams
-		if q == nil {
-			q = make(map[string]string)
+
+	var entries []subuserAccessEntry
+	var payload teammateSubuserAccessResponse
+	if fetchAll {
+		pageSize := int64(defaultSubuserAccessPageSize)
+		if !state.Limit.IsNull() && !state.Limit.IsUnknown() {
+			pageSize = state.Limit.ValueInt64()
 		}
-		for k, v := range queryParams {
-			q[k] = v
+		hasRestricted, all, diags := d.client.teammateSubuserAccess(ctx, teammateName, "", pageSize)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-		request.QueryParams = q
-	}
+		entries = all
+		payload.HasRestrictedSubuserAccess = hasRestricted
+	} else {
+		// Build request using sendgrid-go with provider-configured BaseURL (EU/US support).
+		p := "/v3/teammates/" + teammateName + "/subuser_access"
+		request := sendgrid.GetRequest(d.client.APIKey, p, d.client.BaseURL)
+		request.Method = "GET"
 
-	sgResp, err := sendgrid.API(request)
-	if err != nil {
-		resp.Diagnostics.AddError("SendGrid API request failed", err.Error())
-		return
-	}
+		// Add query parameters if provided
+		queryParams := make(map[string]string)
+		if !state.Limit.IsNull() && !state.Limit.IsUnknown() {
+			queryParams["limit"] = strconv.FormatInt(state.Limit.ValueInt64(), 10)
+		}
+		if afterID > 0 {
+			queryParams["after_subuser_id"] = strconv.FormatInt(afterID, 10)
+		}
+		if !state.Username.IsNull() && !state.Username.IsUnknown() {
+			queryParams["username"] = state.Username.ValueString()
+		}
+		if len(queryParams) > 0 {
+			q := request.QueryParams
+			if q == nil {
+				q = make(map[string]string)
+			}
+			for k, v := range queryParams {
+				q[k] = v
+			}
+			request.QueryParams = q
+		}
 
-	if sgResp.StatusCode >= 300 {
-		resp.Diagnostics.AddError(
-			"SendGrid API error",
-			fmt.Sprintf("HTTP %d while fetching teammate subuser access '%s': %s", sgResp.StatusCode, teammateName, sgResp.Body),
-		)
-		return
-	}
+		sgResp, err := sendgrid.API(request)
+		if err != nil {
+			resp.Diagnostics.AddError("SendGrid API request failed", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(deprecationWarnings(string(request.Method), request.BaseURL, sgResp.Headers)...)
 
-	var payload struct {
-		HasRestrictedSubuserAccess bool `json:"has_restricted_subuser_access"`
-		SubuserAccess              []struct {
-			ID             int64    `json:"id"`
-			Username       string   `json:"username"`
-			Email          string   `json:"email"`
-			Disabled       bool     `json:"disabled"`
-			PermissionType string   `json:"permission_type"`
-			Scopes         []string `json:"scopes"`
-		} `json:"subuser_access"`
-		Metadata struct {
-			NextParams struct {
-				Limit          int64  `json:"limit"`
-				AfterSubuserID int64  `json:"after_subuser_id"`
-				Username       string `json:"username"`
-			} `json:"next_params"`
-		} `json:"_metadata"`
-	}
+		if sgResp.StatusCode >= 300 {
+			resp.Diagnostics.AddError(
+				"SendGrid API error",
+				fmt.Sprintf("HTTP %d while fetching teammate subuser access '%s': %s", sgResp.StatusCode, teammateName, sgResp.Body),
+			)
+			return
+		}
 
-	if err := json.Unmarshal([]byte(sgResp.Body), &payload); err != nil {
-		resp.Diagnostics.AddError("Failed to parse API response", fmt.Sprintf("Unable to parse JSON body: %v", err))
-		return
+		if err := json.Unmarshal([]byte(sgResp.Body), &payload); err != nil {
+			resp.Diagnostics.AddError("Failed to parse API response", fmt.Sprintf("Unable to parse JSON body: %v", err))
+			return
+		}
+		for _, item := range payload.SubuserAccess {
+			entries = append(entries, subuserAccessEntry{
+				ID:             item.ID,
+				Username:       item.Username,
+				Email:          item.Email,
+				Disabled:       item.Disabled,
+				PermissionType: item.PermissionType,
+				Scopes:         item.Scopes,
+			})
+		}
 	}
 
+	state.ID = types.StringValue(dataSourceID("teammate_subuser_access", map[string]attr.Value{
+		"teammate_name":    state.TeammateName,
+		"limit":            state.Limit,
+		"after_subuser_id": state.AfterSubuserID,
+		"fetch_all":        state.FetchAll,
+		"permission_type":  state.PermissionType,
+		"exclude_disabled": state.ExcludeDisabled,
+		"username":         state.Username,
+	}))
 	state.HasRestrictedSubuserAccess = types.BoolValue(payload.HasRestrictedSubuserAccess)
-
-	subuserAccessList := make([]subuserAccessModel, 0, len(payload.SubuserAccess))
-	for _, item := range payload.SubuserAccess {
-		scopeVals := make([]attr.Value, 0, len(item.Scopes))
-		for _, s := range item.Scopes {
-			scopeVals = append(scopeVals, types.StringValue(s))
-		}
-		setVal, diagSet := types.SetValue(types.StringType, scopeVals)
-		resp.Diagnostics.Append(diagSet...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		subuserAccessList = append(subuserAccessList, subuserAccessModel{
-			ID:             types.Int64Value(item.ID),
-			Username:       types.StringValue(item.Username),
-			Email:          types.StringValue(item.Email),
-			Disabled:       types.BoolValue(item.Disabled),
-			PermissionType: types.StringValue(item.PermissionType),
-			Scopes:         setVal,
-		})
+	state.SubuserAccess = subuserAccessModelsFromEntries(filterSubuserAccess(entries, state.PermissionType.ValueString(), state.ExcludeDisabled.ValueBool()), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.SubuserAccessByID = make(map[string]subuserAccessModel, len(state.SubuserAccess))
+	state.SubuserAccessByUsername = make(map[string]subuserAccessModel, len(state.SubuserAccess))
+	for _, m := range state.SubuserAccess {
+		state.SubuserAccessByID[strconv.FormatInt(m.ID.ValueInt64(), 10)] = m
+		state.SubuserAccessByUsername[m.Username.ValueString()] = m
 	}
-	state.SubuserAccess = subuserAccessList
 
-	// Pagination hints
+	// Pagination hints (from the page fetched; zero after fetch_all)
 	state.NextLimit = types.Int64Value(payload.Metadata.NextParams.Limit)
 	state.NextAfterSubuserID = types.Int64Value(payload.Metadata.NextParams.AfterSubuserID)
 	state.NextUsername = types.StringValue(payload.Metadata.NextParams.Username)
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	prov "github.com/diamond-cto/terraform-provider-sendgrid/internal/provider"
+	"github.com/diamond-cto/terraform-provider-sendgrid/internal/testacc"
 )
 
 // buildConfig returns an HCL config for the teammate_subuser_access data source.
@@ -82,10 +83,7 @@ func TestAccDataTeammateSubuserAccess_basic(t *testing.T) {
 		t.Skip("SENDGRID_API_KEY not set; skipping acceptance test")
 	}
 
-	teammate := os.Getenv("TEST_TEAMMATE_NAME")
-	if teammate == "" {
-		t.Skip("TEST_TEAMMATE_NAME not set; skipping TestAccDataTeammateSubuserAccess_basic")
-	}
+	teammate := testacc.SSOTeammate(t)
 
 	cfg := buildConfig(teammate, "", "1", "") // limit=1 で next_* の挙動確認がしやすい
 
@@ -128,10 +126,7 @@ func TestAccDataTeammateSubuserAccess_noUsername(t *testing.T) {
 		t.Skip("SENDGRID_API_KEY not set; skipping acceptance test")
 	}
 
-	teammate := os.Getenv("TEST_TEAMMATE_NAME")
-	if teammate == "" {
-		t.Skip("TEST_TEAMMATE_NAME not set; skipping TestAccDataTeammateSubuserAccess_noUsername")
-	}
+	teammate := testacc.SSOTeammate(t)
 
 	// username を指定しない（空）
 	cfg := buildConfig(teammate, "", "", "")
@@ -158,3 +153,38 @@ func TestAccDataTeammateSubuserAccess_noUsername(t *testing.T) {
 		}},
 	})
 }
+
+func TestAccDataTeammateSubuserAccess_fetchAll(t *testing.T) {
+	t.Parallel()
+
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("TF_ACC not set; skipping acceptance test")
+	}
+	if os.Getenv("SENDGRID_API_KEY") == "" {
+		t.Skip("SENDGRID_API_KEY not set; skipping acceptance test")
+	}
+
+	teammate := testacc.SSOTeammate(t)
+
+	// limit=1 でも fetch_all で全ページを辿る
+	cfg := buildConfig(teammate, "", "1", "")
+	cfg = regexp.MustCompile(`\n}\n$`).ReplaceAllString(cfg, "\n  fetch_all = true\n}\n")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"sendgrid": providerserver.NewProtocol6WithError(prov.New()),
+		},
+		Steps: []resource.TestStep{{
+			Config: cfg,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				checkListLenGE("data.sendgrid_teammate_subuser_access.t", "subuser_access", 0, t),
+				// 最終ページまで辿ったので次のカーソルは無い
+				resource.TestCheckResourceAttr("data.sendgrid_teammate_subuser_access.t", "next_after_subuser_id", "0"),
+				logAttr("data.sendgrid_teammate_subuser_access.t", "subuser_access.#", t),
+				resource.TestCheckResourceAttrPair(
+					"data.sendgrid_teammate_subuser_access.t", "subuser_access.#",
+					"data.sendgrid_teammate_subuser_access.t", "subuser_access_by_id.%"),
+			),
+		}},
+	})
+}
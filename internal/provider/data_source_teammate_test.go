@@ -21,7 +21,7 @@ func TestAccDataTeammate_basic(t *testing.T) {
 
 	username := os.Getenv("TEST_USERNAME")
 	if username == "" {
-		t.Skip("TEST_USERNAME not set; skipping TestAccDataTeammate_basic")
+		username = testacc.SSOTeammate(t)
 	}
 	onBehalf := os.Getenv("TEST_ON_BEHALF_OF") // 空なら使わない
 
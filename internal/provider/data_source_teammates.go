@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sendgrid/sendgrid-go"
+)
+
+// NOTE: Data source that lists every teammate of the account.
+//
+// API Endpoints:
+//   - GET /v3/teammates?limit&offset
+//
+// API Documentation:
+//   - Retrieve all teammates: https://www.twilio.com/docs/sendgrid/api-reference/teammates/retrieve-all-teammates
+//
+// Pages are requested until a short page is returned, so the result is
+// complete regardless of account size. Pending invitations are not teammates
+// yet and are not listed.
+
+// teammatesPageSize is the limit used when listing teammates.
+var teammatesPageSize = 100
+
+var _ datasource.DataSource = (*TeammatesDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*TeammatesDataSource)(nil)
+
+// TeammatesDataSource implements sendgrid_teammates.
+type TeammatesDataSource struct {
+	client *Client
+}
+
+// NewTeammatesDataSource returns a new instance of the data source.
+func NewTeammatesDataSource() datasource.DataSource {
+	return &TeammatesDataSource{}
+}
+
+type teammatesDataSourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	OnBehalfOf          types.String `tfsdk:"on_behalf_of"`
+	Teammates           types.List   `tfsdk:"teammates"`
+	TeammatesByUsername types.Map    `tfsdk:"teammates_by_username"`
+}
+
+// teammateListItem is one element of GET /v3/teammates.
+type teammateListItem struct {
+	Username  string `json:"username"`
+	Email     string `json:"email"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	UserType  string `json:"user_type"`
+	IsAdmin   bool   `json:"is_admin"`
+}
+
+func teammateListObjectType() types.ObjectType {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"username":   types.StringType,
+		"email":      types.StringType,
+		"first_name": types.StringType,
+		"last_name":  types.StringType,
+		"user_type":  types.StringType,
+		"is_admin":   types.BoolType,
+	}}
+}
+
+func (d *TeammatesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_teammates"
+}
+
+func (d *TeammatesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List every teammate of the account via `GET /v3/teammates`, following pagination, e.g. to compare the teammates in the console with the ones managed by Terraform. Pending invitations are not included.",
+		Attributes: map[string]schema.Attribute{
+			"id": dataSourceIDAttribute(),
+			"on_behalf_of": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Parent account header to impersonate a Subuser: sets the HTTP header `on-behalf-of` to the given subuser username.",
+			},
+			"teammates": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Teammates sorted by username.",
+				NestedObject:        teammateListNestedObject(),
+			},
+			"teammates_by_username": schema.MapNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The elements of `teammates` keyed by username, e.g. for `for_each` or set arithmetic with `keys()`.",
+				NestedObject:        teammateListNestedObject(),
+			},
+		},
+	}
+}
+
+// teammateListNestedObject is the schema of a teammates element.
+func teammateListNestedObject() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Teammate username.",
+			},
+			"email": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Teammate email.",
+			},
+			"first_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "First name.",
+			},
+			"last_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Last name.",
+			},
+			"user_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "`owner`, `admin`, or `teammate`.",
+			},
+			"is_admin": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the teammate has admin permissions.",
+			},
+		},
+	}
+}
+
+func (d *TeammatesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*Client)
+	if !ok || c == nil {
+		resp.Diagnostics.AddError("Unexpected ProviderData", "Expected *Client")
+		return
+	}
+	d.client = c
+}
+
+func (d *TeammatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+
+	var config teammatesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, diags := d.client.listTeammates(ctx, config.OnBehalfOf.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	sort.SliceStable(items, func(i, j int) bool { return items[i].Username < items[j].Username })
+
+	elemType := teammateListObjectType()
+	elems := make([]types.Object, 0, len(items))
+	byUsername := make(map[string]types.Object, len(items))
+	for _, it := range items {
+		obj, d := types.ObjectValue(elemType.AttrTypes, map[string]attr.Value{
+			"username":   types.StringValue(it.Username),
+			"email":      types.StringValue(it.Email),
+			"first_name": stringOrNull(it.FirstName),
+			"last_name":  stringOrNull(it.LastName),
+			"user_type":  stringOrNull(it.UserType),
+			"is_admin":   types.BoolValue(it.IsAdmin),
+		})
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		elems = append(elems, obj)
+		byUsername[it.Username] = obj
+	}
+
+	var d1, d2 diag.Diagnostics
+	config.Teammates, d1 = types.ListValueFrom(ctx, elemType, elems)
+	config.TeammatesByUsername, d2 = types.MapValueFrom(ctx, elemType, byUsername)
+	resp.Diagnostics.Append(d1...)
+	resp.Diagnostics.Append(d2...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.ID = types.StringValue(dataSourceID("teammates", map[string]attr.Value{
+		"on_behalf_of": config.OnBehalfOf,
+	}))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// listTeammates pages through GET /v3/teammates and returns every teammate.
+// onBehalfOf, when non-empty, is sent as the `on-behalf-of` header.
+func (c *Client) listTeammates(ctx context.Context, onBehalfOf string) ([]teammateListItem, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var all []teammateListItem
+	for offset := 0; ; offset += teammatesPageSize {
+		reqSG := sendgrid.GetRequest(c.APIKey, "/v3/teammates", c.BaseURL)
+		reqSG.Method = "GET"
+		reqSG.QueryParams = map[string]string{
+			"limit":  strconv.Itoa(teammatesPageSize),
+			"offset": strconv.Itoa(offset),
+		}
+		if onBehalfOf != "" {
+			reqSG.Headers["on-behalf-of"] = onBehalfOf
+		}
+
+		tflog.Debug(ctx, "GET /v3/teammates", map[string]any{"offset": offset})
+		sgResp, err := sendgrid.API(reqSG)
+		if err != nil {
+			diags.AddError("SendGrid API error (teammates)", err.Error())
+			return nil, diags
+		}
+		diags.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+		if sgResp.StatusCode >= 300 {
+			diags.AddError("List teammates failed", fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+			return nil, diags
+		}
+		var page struct {
+			Result []teammateListItem `json:"result"`
+		}
+		if err := json.Unmarshal([]byte(sgResp.Body), &page); err != nil {
+			diags.AddError("Parse error (teammates)", fmt.Sprintf("unable to parse body: %v", err))
+			return nil, diags
+		}
+		all = append(all, page.Result...)
+		if len(page.Result) < teammatesPageSize {
+			return all, diags
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestListTeammates_Paginates(t *testing.T) {
+	old := teammatesPageSize
+	teammatesPageSize = 2
+	defer func() { teammatesPageSize = old }()
+
+	var all []teammateListItem
+	for i := 0; i < 5; i++ {
+		all = append(all, teammateListItem{Username: fmt.Sprintf("user%d", i), Email: fmt.Sprintf("user%d@example.com", i), UserType: "teammate"})
+	}
+	all[0].UserType, all[0].IsAdmin = "owner", true
+
+	var headers []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v3/teammates" {
+			http.NotFound(w, r)
+			return
+		}
+		headers = append(headers, r.Header.Get("on-behalf-of"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		end := min(offset+limit, len(all))
+		_ = json.NewEncoder(w).Encode(map[string]any{"result": all[min(offset, end):end]})
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, APIKey: "test-key"}
+	got, diags := c.listTeammates(context.Background(), "sub1")
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if len(got) != 5 || got[4].Username != "user4" || !got[0].IsAdmin || got[0].UserType != "owner" {
+		t.Fatalf("unexpected teammates: %+v", got)
+	}
+	if len(headers) != 3 || headers[0] != "sub1" {
+		t.Fatalf("expected 3 pages with on-behalf-of, got %v", headers)
+	}
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// NOTE: SendGrid announces endpoint retirement with the Deprecation (RFC 9745)
+// and Sunset (RFC 8594) response headers, optionally with a Link to the
+// migration notes. Every API call passes its response headers through
+// deprecationWarnings, so normal plans surface upcoming breakage as warnings;
+// Terraform attributes each warning to the resource or data source that made
+// the call.
+
+// deprecationWarnings returns a warning naming the endpoint when headers carry
+// Deprecation or Sunset. endpoint is a request path or the full request URL.
+func deprecationWarnings(method, endpoint string, headers map[string][]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	h := http.Header(headers)
+	deprecation, sunset := h.Get("Deprecation"), h.Get("Sunset")
+	if deprecation == "" && sunset == "" {
+		return diags
+	}
+
+	var notes []string
+	if deprecation != "" {
+		notes = append(notes, "Deprecation: "+deprecation)
+	}
+	if sunset != "" {
+		notes = append(notes, "Sunset: "+sunset)
+	}
+	detail := fmt.Sprintf("SendGrid reports that %s %s is deprecated (%s) and may stop working.",
+		method, endpointPath(endpoint), strings.Join(notes, ", "))
+	if link := deprecationLink(h); link != "" {
+		detail += " See " + link + "."
+	}
+	diags.AddWarning("SendGrid API endpoint is deprecated", detail)
+	return diags
+}
+
+// legacyEndpointWarning returns a warning for endpoints SendGrid documents as
+// legacy, which carry no Deprecation header but receive no new features.
+func legacyEndpointWarning(method, endpoint, replacement string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.AddWarning("SendGrid legacy API endpoint in use",
+		fmt.Sprintf("%s %s is documented by SendGrid as a legacy API. Consider migrating to %s.",
+			method, endpointPath(endpoint), replacement))
+	return diags
+}
+
+// endpointPath strips the scheme, host, and query from a request URL.
+func endpointPath(endpoint string) string {
+	if u, err := url.Parse(endpoint); err == nil && u.Path != "" {
+		return u.Path
+	}
+	return endpoint
+}
+
+// deprecationLink returns the target of a Link header with rel="deprecation"
+// or rel="sunset", if any.
+func deprecationLink(h http.Header) string {
+	for _, v := range h.Values("Link") {
+		for _, link := range strings.Split(v, ",") {
+			target, params, ok := strings.Cut(strings.TrimSpace(link), ";")
+			if !ok {
+				continue
+			}
+			rel := strings.ReplaceAll(strings.ToLower(params), " ", "")
+			if strings.Contains(rel, `rel="deprecation"`) || strings.Contains(rel, `rel="sunset"`) ||
+				strings.Contains(rel, "rel=deprecation") || strings.Contains(rel, "rel=sunset") {
+				return strings.Trim(strings.TrimSpace(target), "<>")
+			}
+		}
+	}
+	return ""
+}
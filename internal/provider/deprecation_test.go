@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDeprecationWarnings(t *testing.T) {
+	if diags := deprecationWarnings("GET", "/v3/scopes", map[string][]string{"Content-Type": {"application/json"}}); len(diags) != 0 {
+		t.Fatalf("expected no warning without deprecation headers, got %v", diags)
+	}
+
+	diags := deprecationWarnings("GET", "https://api.sendgrid.com/v3/templates?generations=legacy", map[string][]string{
+		"Deprecation": {"@1767225600"},
+		"Sunset":      {"Wed, 30 Jun 2027 23:59:59 GMT"},
+		"Link":        {`<https://www.twilio.com/docs/sendgrid/migration>; rel="deprecation"; type="text/html"`},
+	})
+	if diags.WarningsCount() != 1 || diags.HasError() {
+		t.Fatalf("expected exactly one warning, got %v", diags)
+	}
+	detail := diags[0].Detail()
+	for _, want := range []string{"GET /v3/templates ", "Sunset: Wed, 30 Jun 2027", "https://www.twilio.com/docs/sendgrid/migration"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("warning detail %q does not contain %q", detail, want)
+		}
+	}
+}
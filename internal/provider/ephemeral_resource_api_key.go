@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sendgrid/sendgrid-go"
+)
+
+// NOTE: Ephemeral resource (Terraform 1.10+) that creates a short-lived API
+// key for the duration of a plan or apply.
+//
+// API Endpoints:
+//   - Open:  POST   /v3/api_keys
+//   - Close: DELETE /v3/api_keys/{api_key_id}
+//
+// API Documentation:
+//   - Create API keys: https://www.twilio.com/docs/sendgrid/api-reference/api-keys/create-api-keys
+//   - Delete API keys: https://www.twilio.com/docs/sendgrid/api-reference/api-keys/delete-api-keys
+//
+// The secret is only returned on creation and is never written to state or
+// plan. Terraform closes the resource once it is no longer needed, which
+// deletes the key; a key that is already gone is not an error.
+
+var _ ephemeral.EphemeralResource = (*APIKeyEphemeralResource)(nil)
+var _ ephemeral.EphemeralResourceWithConfigure = (*APIKeyEphemeralResource)(nil)
+var _ ephemeral.EphemeralResourceWithClose = (*APIKeyEphemeralResource)(nil)
+
+func NewAPIKeyEphemeralResource() ephemeral.EphemeralResource { return &APIKeyEphemeralResource{} }
+
+type APIKeyEphemeralResource struct{ client *Client }
+
+type apiKeyEphemeralModel struct {
+	Name       types.String `tfsdk:"name"`
+	Scopes     types.Set    `tfsdk:"scopes"`
+	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
+	APIKeyID   types.String `tfsdk:"api_key_id"`
+	APIKey     types.String `tfsdk:"api_key"`
+}
+
+// apiKeyPrivateKey is the private data key holding what Close needs to delete
+// the key.
+const apiKeyPrivateKey = "api_key"
+
+type apiKeyPrivate struct {
+	APIKeyID   string `json:"api_key_id"`
+	OnBehalfOf string `json:"on_behalf_of,omitempty"`
+}
+
+func (r *APIKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (r *APIKeyEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pc, ok := req.ProviderData.(*Client)
+	if !ok || pc == nil {
+		resp.Diagnostics.AddError("Unexpected ProviderData",
+			"Expected *Client, got something else")
+		return
+	}
+	r.client = pc
+}
+
+func (r *APIKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Create a short-lived API key via `POST /v3/api_keys` for the duration of a Terraform run, e.g. a transient sending key for a pipeline step. The secret is never stored in state or plan, and the key is deleted once Terraform no longer needs it. Requires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the API key, e.g. including a pipeline run ID so leftover keys can be traced.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"scopes": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Scopes granted to the key, e.g. `[\"mail.send\"]`. When omitted, SendGrid grants the key full access.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"on_behalf_of": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Parent account header to impersonate a Subuser: sets the HTTP header `on-behalf-of` to the given subuser username.",
+			},
+			"api_key_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the created API key.",
+			},
+			"api_key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The API key secret.",
+			},
+		},
+	}
+}
+
+// Open creates the API key.
+// POST /v3/api_keys
+func (r *APIKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	resp.Diagnostics.Append(r.client.requireScopes(ctx, "create API key", "api_keys.create", "api_keys.delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data apiKeyEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := map[string]any{"name": data.Name.ValueString()}
+	if !data.Scopes.IsNull() {
+		var scopes []string
+		resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &scopes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		payload["scopes"] = scopes
+	}
+
+	id, key, diags := r.client.createAPIKey(ctx, payload, data.OnBehalfOf.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	b, _ := json.Marshal(apiKeyPrivate{APIKeyID: id, OnBehalfOf: data.OnBehalfOf.ValueString()})
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, apiKeyPrivateKey, b)...)
+
+	data.APIKeyID = types.StringValue(id)
+	data.APIKey = types.StringValue(key)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// Close deletes the API key created by Open.
+// DELETE /v3/api_keys/{api_key_id}
+func (r *APIKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+
+	b, diags := req.Private.GetKey(ctx, apiKeyPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(b) == 0 {
+		return
+	}
+	var priv apiKeyPrivate
+	if err := json.Unmarshal(b, &priv); err != nil {
+		resp.Diagnostics.AddError("Parse error (private state)", fmt.Sprintf("unable to parse api key private state: %v", err))
+		return
+	}
+	resp.Diagnostics.Append(r.client.deleteAPIKey(ctx, priv.APIKeyID, priv.OnBehalfOf)...)
+}
+
+// createAPIKey creates an API key and returns its ID and secret.
+// POST /v3/api_keys
+func (c *Client) createAPIKey(ctx context.Context, payload map[string]any, onBehalfOf string) (id, key string, diags diag.Diagnostics) {
+	b, _ := json.Marshal(payload)
+	reqSG := sendgrid.GetRequest(c.APIKey, "/v3/api_keys", c.BaseURL)
+	reqSG.Method = "POST"
+	reqSG.Body = b
+	if onBehalfOf != "" {
+		reqSG.Headers["on-behalf-of"] = onBehalfOf
+	}
+
+	tflog.Debug(ctx, "POST /v3/api_keys", map[string]any{"name": payload["name"]})
+
+	sgResp, err := sendgrid.API(reqSG)
+	c.invalidateGetCache()
+	if err != nil {
+		diags.AddError("SendGrid API error", err.Error())
+		return "", "", diags
+	}
+	diags.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+	if sgResp.StatusCode >= 300 {
+		diags.AddError(
+			fmt.Sprintf("Create API key failed: %s", apiErrorMessage(sgResp.Body)),
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+		return "", "", diags
+	}
+	var out struct {
+		APIKey   string `json:"api_key"`
+		APIKeyID string `json:"api_key_id"`
+	}
+	if err := json.Unmarshal([]byte(sgResp.Body), &out); err != nil {
+		// The body holds the secret, so it is left out of the error.
+		diags.AddError("Parse error (create API key)", fmt.Sprintf("unable to parse body: %v", err))
+		return "", "", diags
+	}
+	return out.APIKeyID, out.APIKey, diags
+}
+
+// deleteAPIKey deletes an API key. A key that is already gone is not an error.
+// DELETE /v3/api_keys/{api_key_id}
+func (c *Client) deleteAPIKey(ctx context.Context, id, onBehalfOf string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	reqSG := sendgrid.GetRequest(c.APIKey, "/v3/api_keys/"+url.PathEscape(id), c.BaseURL)
+	reqSG.Method = "DELETE"
+	if onBehalfOf != "" {
+		reqSG.Headers["on-behalf-of"] = onBehalfOf
+	}
+
+	tflog.Debug(ctx, "DELETE /v3/api_keys/{api_key_id}", map[string]any{"api_key_id": id})
+
+	sgResp, err := sendgrid.API(reqSG)
+	c.invalidateGetCache()
+	if err != nil {
+		diags.AddError("SendGrid API error", err.Error())
+		return diags
+	}
+	diags.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+	if sgResp.StatusCode >= 300 && sgResp.StatusCode != 404 {
+		diags.AddError(
+			fmt.Sprintf("Delete API key %s failed: %s", id, apiErrorMessage(sgResp.Body)),
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+	}
+	return diags
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAPIKeyEphemeral_CreateAndDelete(t *testing.T) {
+	var created map[string]any
+	deleted := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v3/api_keys":
+			_ = json.NewDecoder(r.Body).Decode(&created)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"api_key":"SG.secret","api_key_id":"key-1","name":"ci","scopes":["mail.send"]}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/v3/api_keys/key-1":
+			deleted++
+			if deleted > 1 {
+				http.NotFound(w, r)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := &Client{BaseURL: srv.URL, APIKey: "test-key"}
+
+	id, key, diags := c.createAPIKey(ctx, map[string]any{"name": "ci", "scopes": []string{"mail.send"}}, "")
+	if diags.HasError() || id != "key-1" || key != "SG.secret" {
+		t.Fatalf("create: id=%q key=%q diags=%v", id, key, diags)
+	}
+	if created["name"] != "ci" {
+		t.Fatalf("unexpected payload: %v", created)
+	}
+
+	if diags := c.deleteAPIKey(ctx, "key-1", ""); diags.HasError() {
+		t.Fatalf("delete: %v", diags)
+	}
+	// A key that is already gone is not an error.
+	if diags := c.deleteAPIKey(ctx, "key-1", ""); diags.HasError() {
+		t.Fatalf("second delete: %v", diags)
+	}
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// NOTE: Shared helpers for the mail settings resources.
+//
+// API Endpoints:
+//   - Read:   GET   /v3/mail_settings/{setting}
+//   - Update: PATCH /v3/mail_settings/{setting}
+//
+// API Documentation:
+//   - Mail Settings: https://www.twilio.com/docs/sendgrid/api-reference/settings-mail
+//
+// Each setting exists once per account (or per subuser, via the on-behalf-of
+// header), so create and update both PATCH it, and delete PATCHes it back to
+// disabled. The id, on_behalf_of and import handling are the account-scoped
+// ones of settings.go.
+
+// readMailSetting decodes GET /v3/mail_settings/{setting} into out.
+func (c *Client) readMailSetting(ctx context.Context, setting, onBehalfOf string, out any) diag.Diagnostics {
+	return c.settingRequest(ctx, "/v3/mail_settings/"+setting, "mail setting "+setting, onBehalfOf, nil, out)
+}
+
+// writeMailSetting PATCHes the setting with payload and decodes the returned
+// setting into out.
+func (c *Client) writeMailSetting(ctx context.Context, setting, onBehalfOf string, payload, out any) diag.Diagnostics {
+	return c.writeSetting(ctx, "/v3/mail_settings/"+setting, "mail setting "+setting, onBehalfOf, payload, out)
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMailSetting_WriteAndReadOnBehalfOf(t *testing.T) {
+	stored := mailSettingsBCCAPI{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/mail_settings/bcc" {
+			http.NotFound(w, r)
+			return
+		}
+		if got := r.Header.Get("on-behalf-of"); got != "sub-1" {
+			t.Errorf("on-behalf-of = %q", got)
+		}
+		if r.Method == http.MethodPatch {
+			_ = json.NewDecoder(r.Body).Decode(&stored)
+		}
+		_ = json.NewEncoder(w).Encode(stored)
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, APIKey: "test-key"}
+	var got mailSettingsBCCAPI
+	if diags := c.writeMailSetting(context.Background(), "bcc", "sub-1", mailSettingsBCCAPI{Enabled: true, Email: "archive@example.com"}, &got); diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if !got.Enabled || got.Email != "archive@example.com" {
+		t.Fatalf("unexpected PATCH result: %+v", got)
+	}
+
+	got = mailSettingsBCCAPI{}
+	if diags := c.readMailSetting(context.Background(), "bcc", "sub-1", &got); diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if !got.Enabled || got.Email != "archive@example.com" {
+		t.Fatalf("unexpected GET result: %+v", got)
+	}
+}
+
+func TestMailSettingsSpamCheckModel_PayloadOmitsUnknownMaxScore(t *testing.T) {
+	m := mailSettingsSpamCheckModel{Enabled: types.BoolValue(true), MaxScore: types.Int64Unknown(), URL: types.StringUnknown()}
+	b, _ := json.Marshal(m.payload())
+	if string(b) != `{"enabled":true}` {
+		t.Fatalf("unexpected payload: %s", b)
+	}
+
+	m.MaxScore = types.Int64Value(5)
+	m.URL = types.StringValue("https://parse.example.com/spam")
+	b, _ = json.Marshal(m.payload())
+	if string(b) != `{"enabled":true,"max_score":5,"url":"https://parse.example.com/spam"}` {
+		t.Fatalf("unexpected payload: %s", b)
+	}
+}
+
+func TestLegacyTemplateBodyTag(t *testing.T) {
+	for html, want := range map[string]bool{
+		"<html><body><% body %></body></html>": true,
+		"<html><%body%></html>":                true,
+		"<html><body></body></html>":           false,
+	} {
+		if got := legacyTemplateBodyTag.MatchString(html); got != want {
+			t.Errorf("%q: got %v, want %v", html, got, want)
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NOTE: Shared helpers for the Marketing Campaigns stats data sources.
+//
+// API Endpoints:
+//   - Automations:  GET /v3/marketing/stats/automations/{id}
+//   - Single Sends: GET /v3/marketing/stats/singlesends/{id}
+//
+// Both return {results: [{id, aggregation, ..., stats: {...}}], _metadata}
+// and page with page_token, which is read from _metadata.next. Both accept the
+// same aggregated_by/start_date/end_date/timezone options, declared once in
+// marketingStatsRangeAttributes; the API only aggregates by total or day.
+
+// marketingStatsPageSize is the page_size used when walking stats results.
+var marketingStatsPageSize = 50
+
+// marketingStats is the metrics object of a stats result.
+type marketingStats struct {
+	BounceDrops     int64 `json:"bounce_drops"`
+	Bounces         int64 `json:"bounces"`
+	Clicks          int64 `json:"clicks"`
+	Delivered       int64 `json:"delivered"`
+	InvalidEmails   int64 `json:"invalid_emails"`
+	Opens           int64 `json:"opens"`
+	Requests        int64 `json:"requests"`
+	SpamReportDrops int64 `json:"spam_report_drops"`
+	SpamReports     int64 `json:"spam_reports"`
+	UniqueClicks    int64 `json:"unique_clicks"`
+	UniqueOpens     int64 `json:"unique_opens"`
+	Unsubscribes    int64 `json:"unsubscribes"`
+}
+
+// add accumulates o into s.
+func (s *marketingStats) add(o marketingStats) {
+	s.BounceDrops += o.BounceDrops
+	s.Bounces += o.Bounces
+	s.Clicks += o.Clicks
+	s.Delivered += o.Delivered
+	s.InvalidEmails += o.InvalidEmails
+	s.Opens += o.Opens
+	s.Requests += o.Requests
+	s.SpamReportDrops += o.SpamReportDrops
+	s.SpamReports += o.SpamReports
+	s.UniqueClicks += o.UniqueClicks
+	s.UniqueOpens += o.UniqueOpens
+	s.Unsubscribes += o.Unsubscribes
+}
+
+// marketingStatsResult is one element of the results array. Only the fields
+// relevant to the requested grouping are set.
+type marketingStatsResult struct {
+	ID          string         `json:"id"`
+	Aggregation string         `json:"aggregation"`
+	StepID      string         `json:"step_id"`
+	ABVariation string         `json:"ab_variation"`
+	ABPhase     string         `json:"ab_phase"`
+	Stats       marketingStats `json:"stats"`
+}
+
+var marketingStatsAttrNames = []string{
+	"bounce_drops", "bounces", "clicks", "delivered", "invalid_emails", "opens",
+	"requests", "spam_report_drops", "spam_reports", "unique_clicks", "unique_opens", "unsubscribes",
+}
+
+func marketingStatsObjectType() types.ObjectType {
+	attrs := make(map[string]attr.Type, len(marketingStatsAttrNames))
+	for _, name := range marketingStatsAttrNames {
+		attrs[name] = types.Int64Type
+	}
+	return types.ObjectType{AttrTypes: attrs}
+}
+
+// marketingStatsSchema returns the computed nested attribute for a metrics object.
+func marketingStatsSchema(description string) schema.SingleNestedAttribute {
+	attrs := make(map[string]schema.Attribute, len(marketingStatsAttrNames))
+	for _, name := range marketingStatsAttrNames {
+		attrs[name] = schema.Int64Attribute{Computed: true}
+	}
+	return schema.SingleNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: description,
+		Attributes:          attrs,
+	}
+}
+
+func marketingStatsToObject(s marketingStats) (types.Object, diag.Diagnostics) {
+	return types.ObjectValue(marketingStatsObjectType().AttrTypes, map[string]attr.Value{
+		"bounce_drops":      types.Int64Value(s.BounceDrops),
+		"bounces":           types.Int64Value(s.Bounces),
+		"clicks":            types.Int64Value(s.Clicks),
+		"delivered":         types.Int64Value(s.Delivered),
+		"invalid_emails":    types.Int64Value(s.InvalidEmails),
+		"opens":             types.Int64Value(s.Opens),
+		"requests":          types.Int64Value(s.Requests),
+		"spam_report_drops": types.Int64Value(s.SpamReportDrops),
+		"spam_reports":      types.Int64Value(s.SpamReports),
+		"unique_clicks":     types.Int64Value(s.UniqueClicks),
+		"unique_opens":      types.Int64Value(s.UniqueOpens),
+		"unsubscribes":      types.Int64Value(s.Unsubscribes),
+	})
+}
+
+// statsDateLayout is the date format of the stats endpoints.
+const statsDateLayout = "2006-01-02"
+
+// marketingStatsRangeAttributes returns the aggregation and date range
+// arguments shared by the marketing stats data sources.
+func marketingStatsRangeAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"aggregated_by": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "`total` (API default) or `day`.",
+			Validators: []validator.String{
+				stringvalidator.OneOf("total", "day"),
+			},
+		},
+		"start_date": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "First day to include, `YYYY-MM-DD`. Used with `aggregated_by = \"day\"`.",
+			Validators:          []validator.String{statsDateValidator{}},
+		},
+		"end_date": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Last day to include, `YYYY-MM-DD`, not before `start_date`. Used with `aggregated_by = \"day\"`.",
+			Validators:          []validator.String{statsDateValidator{}},
+		},
+		"timezone": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "IANA timezone used to bucket days, e.g. `Europe/Berlin`. Defaults to UTC.",
+		},
+	}
+}
+
+// validateMarketingStatsRange rejects an end_date before start_date.
+func validateMarketingStatsRange(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) {
+	var start, end types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("start_date"), &start)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("end_date"), &end)...)
+	if diags.HasError() || start.IsNull() || start.IsUnknown() || end.IsNull() || end.IsUnknown() {
+		return
+	}
+	s, errS := time.Parse(statsDateLayout, start.ValueString())
+	e, errE := time.Parse(statsDateLayout, end.ValueString())
+	if errS != nil || errE != nil {
+		return // reported by statsDateValidator
+	}
+	if e.Before(s) {
+		diags.AddAttributeError(path.Root("end_date"), "Invalid date range",
+			fmt.Sprintf("end_date %s is before start_date %s.", end.ValueString(), start.ValueString()))
+	}
+}
+
+// statsDateValidator requires a YYYY-MM-DD date.
+type statsDateValidator struct{}
+
+var _ validator.String = statsDateValidator{}
+
+func (v statsDateValidator) Description(_ context.Context) string {
+	return "value must be a date in YYYY-MM-DD format"
+}
+
+func (v statsDateValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v statsDateValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := time.Parse(statsDateLayout, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid date",
+			fmt.Sprintf("%s must be a date such as 2025-01-31 (YYYY-MM-DD), got %q.", req.Path, req.ConfigValue.ValueString()))
+	}
+}
+
+// marketingStatsResults walks every page of a stats endpoint.
+func (c *Client) marketingStatsResults(ctx context.Context, path string, query map[string]string) ([]marketingStatsResult, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var out []marketingStatsResult
+
+	q := make(map[string]string, len(query)+2)
+	for k, v := range query {
+		q[k] = v
+	}
+	q["page_size"] = strconv.Itoa(marketingStatsPageSize)
+	for {
+		var page struct {
+			Results  []marketingStatsResult `json:"results"`
+			Metadata struct {
+				Next string `json:"next"`
+			} `json:"_metadata"`
+		}
+		if !c.getJSON(ctx, path, q, &page, &diags) {
+			return nil, diags
+		}
+		out = append(out, page.Results...)
+
+		token := ""
+		if page.Metadata.Next != "" {
+			if u, err := url.Parse(page.Metadata.Next); err == nil {
+				token = u.Query().Get("page_token")
+			}
+		}
+		if token == "" || len(page.Results) == 0 {
+			return out, diags
+		}
+		q["page_token"] = token
+	}
+}
+
+// stringOrNull maps an empty API string to null.
+func stringOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMarketingStatsResults_FollowsPageToken(t *testing.T) {
+	var tokens []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/marketing/stats/automations/auto-1" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.URL.Query().Get("group_by") != "step_id" {
+			t.Errorf("group_by = %q", r.URL.Query().Get("group_by"))
+		}
+		token := r.URL.Query().Get("page_token")
+		tokens = append(tokens, token)
+		if token == "" {
+			_, _ = w.Write([]byte(`{"results":[{"id":"auto-1","step_id":"s1","aggregation":"total","stats":{"delivered":10,"opens":4}}],
+				"_metadata":{"next":"https://api.sendgrid.com/v3/marketing/stats/automations/auto-1?page_size=50&page_token=abc"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"results":[{"id":"auto-1","step_id":"s2","aggregation":"total","stats":{"delivered":5,"opens":1}}],"_metadata":{}}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, APIKey: "test-key"}
+	results, diags := c.marketingStatsResults(context.Background(), "/v3/marketing/stats/automations/auto-1", map[string]string{"group_by": "step_id"})
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if len(results) != 2 || len(tokens) != 2 || tokens[1] != "abc" {
+		t.Fatalf("results=%+v tokens=%v", results, tokens)
+	}
+
+	var totals marketingStats
+	for _, r := range results {
+		totals.add(r.Stats)
+	}
+	if totals.Delivered != 15 || totals.Opens != 5 {
+		t.Fatalf("totals = %+v", totals)
+	}
+	if _, d := marketingStatsToObject(totals); d.HasError() {
+		t.Fatalf("marketingStatsToObject: %v", d)
+	}
+}
+
+func TestStatsDateValidator(t *testing.T) {
+	for value, wantErr := range map[string]bool{
+		"2025-01-31": false,
+		"2025-02-30": true,
+		"2025-1-31":  true,
+		"01/31/2025": true,
+	} {
+		req := validator.StringRequest{Path: path.Root("start_date"), ConfigValue: types.StringValue(value)}
+		var resp validator.StringResponse
+		statsDateValidator{}.ValidateString(context.Background(), req, &resp)
+		if got := resp.Diagnostics.HasError(); got != wantErr {
+			t.Errorf("%q: error = %v, want %v", value, got, wantErr)
+		}
+	}
+}
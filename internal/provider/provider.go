@@ -2,20 +2,37 @@ package provider
 
 import (
 	"context"
+	"net/url"
 	"os"
 	"reflect"
+	"strings"
+	"sync"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 const defaultBaseURL = "https://api.sendgrid.com"
 
+// regionBaseURLs maps SendGrid data residency regions to their API hosts.
+var regionBaseURLs = map[string]string{
+	"global": defaultBaseURL,
+	"eu":     "https://api.eu.sendgrid.com",
+}
+
+// defaultMaxConcurrency bounds fan-out reads when max_concurrency is unset.
+const defaultMaxConcurrency = 4
+
 // Ensure implementation satisfies the expected interfaces.
 var _ provider.Provider = (*SendGridProvider)(nil)
+var _ provider.ProviderWithEphemeralResources = (*SendGridProvider)(nil)
 
 // New returns a new instance of the SendGrid provider.
 func New() provider.Provider { return &SendGridProvider{} }
@@ -42,6 +59,17 @@ func (p *SendGridProvider) Schema(_ context.Context, _ provider.SchemaRequest, r
 				Sensitive:           true,
 				MarkdownDescription: "SendGrid API key. If unset, the SENDGRID_API_KEY environment variable is used.",
 			},
+			"skip_scope_preflight": providerschema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Before each create, update, or delete, the provider checks (using the cached `GET /v3/scopes` result) that the API key holds the scopes the call needs, and fails with the missing scope named. When the key holds no other scope of that scope's family, the name cannot be verified and only a warning is shown. Set to true to skip this check.",
+			},
+			"max_concurrency": providerschema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum number of concurrent API requests for reads that fan out per item (e.g., `include_details` on `sendgrid_subusers`). Defaults to 4.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -52,6 +80,21 @@ func (p *SendGridProvider) DataSources(_ context.Context) []func() datasource.Da
 		NewTeammateDataSource,
 		NewTeammateSubuserAccessDataSource,
 		NewSubusersDataSource,
+		NewMarketingContactsExportDataSource,
+		NewMarketingAutomationStatsDataSource,
+		NewMarketingSingleSendStatsDataSource,
+		NewInboundParseStatsDataSource,
+		NewMarketingContactsSearchDataSource,
+		NewEmailActivityDownloadDataSource,
+		NewMarketingSingleSendDataSource,
+		NewTeammatesDataSource,
+	}
+}
+
+// EphemeralResources returns the ephemeral resources (Terraform 1.10+).
+func (p *SendGridProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAPIKeyEphemeralResource,
 	}
 }
 
@@ -60,19 +103,59 @@ func (p *SendGridProvider) Resources(_ context.Context) []func() resource.Resour
 	return []func() resource.Resource{
 		NewSSOTeammateResource,
 		NewSubuserResource,
+		NewEventWebhookTestEventResource,
+		NewDomainAuthenticationValidationResource,
+		NewLinkBrandingValidationResource,
+		NewReverseDNSValidationResource,
+		NewTemplateTestEmailResource,
+		NewSuppressionPurgeResource,
+		NewLegacyTemplateVersionResource,
+		NewMarketingSegmentRefreshResource,
+		NewEmailActivityExportResource,
+		NewMailSettingsBCCResource,
+		NewMailSettingsSpamCheckResource,
+		NewMailSettingsTemplateResource,
+		NewListMembershipResource,
+		NewSuppressionGroupMemberResource,
+		NewBounceEntryResource,
+		NewBlockEntryResource,
+		NewSpamReportEntryResource,
+		NewInvalidEmailEntryResource,
+		NewNewRelicPartnerSettingResource,
+		NewSSOTeammateSubuserAccessResource,
+		NewScopeRequestDecisionResource,
+		NewTeammateInviteResendResource,
+		NewDefaultDomainAuthenticationResource,
 	}
 }
 
 // providerModel holds provider configuration fields.
 type providerModel struct {
-	BaseURL types.String `tfsdk:"base_url"`
-	APIKey  types.String `tfsdk:"api_key"`
+	BaseURL            types.String `tfsdk:"base_url"`
+	APIKey             types.String `tfsdk:"api_key"`
+	MaxConcurrency     types.Int64  `tfsdk:"max_concurrency"`
+	SkipScopePreflight types.Bool   `tfsdk:"skip_scope_preflight"`
 }
 
 // Client is a minimal API client placeholder shared with resources/data sources.
 type Client struct {
-	BaseURL string
-	APIKey  string
+	BaseURL        string
+	APIKey         string
+	MaxConcurrency int
+
+	// SkipScopePreflight disables requireScopes.
+	SkipScopePreflight bool
+
+	// scope catalog cache; see scopeCatalog.
+	scopesOnce  sync.Once
+	scopes      map[string]struct{}
+	scopesDiags diag.Diagnostics
+
+	// per-run GET cache; see cachedGet.
+	gets getCache
+
+	// per-teammate locks around subuser access updates; see lockTeammate.
+	teammateLocks sync.Map
 }
 
 // Configure creates a client from configuration and environment variables.
@@ -89,6 +172,25 @@ func (p *SendGridProvider) Configure(ctx context.Context, req provider.Configure
 		}
 	}
 
+	// api_key or base_url may reference a value only known after apply (e.g., a
+	// key created in the same run). Terraform calls Configure again with the
+	// final values during apply; until then no client can be built. Terraform
+	// versions that support deferred actions postpone everything this provider
+	// manages; older ones plan resources without a client, and data sources
+	// fail with an unconfigured-provider error.
+	if cfg.APIKey.IsUnknown() || cfg.BaseURL.IsUnknown() {
+		if req.ClientCapabilities.DeferralAllowed {
+			resp.Deferred = &provider.Deferred{Reason: provider.DeferredReasonProviderConfigUnknown}
+			return
+		}
+		resp.Diagnostics.AddWarning(
+			"Provider configuration unknown",
+			"api_key or base_url is not known until apply, so this plan cannot read from SendGrid. "+
+				"Use a Terraform version with deferred actions, or apply the resources the configuration depends on first (e.g., with -target).",
+		)
+		return
+	}
+
 	// Resolve base URL.
 	baseURL := defaultBaseURL
 	if !cfg.BaseURL.IsNull() && !cfg.BaseURL.IsUnknown() {
@@ -106,11 +208,44 @@ func (p *SendGridProvider) Configure(ctx context.Context, req provider.Configure
 		apiKey = os.Getenv("SENDGRID_API_KEY")
 	}
 
+	maxConcurrency := defaultMaxConcurrency
+	if !cfg.MaxConcurrency.IsNull() && !cfg.MaxConcurrency.IsUnknown() {
+		maxConcurrency = int(cfg.MaxConcurrency.ValueInt64())
+	}
+
 	client := &Client{
-		BaseURL: baseURL,
-		APIKey:  apiKey,
+		BaseURL:        baseURL,
+		APIKey:         apiKey,
+		MaxConcurrency: maxConcurrency,
+
+		SkipScopePreflight: cfg.SkipScopePreflight.ValueBool(),
 	}
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+}
+
+// baseURLRegion returns the region served by a SendGrid API base URL. ok is
+// false for hosts that are not a known SendGrid API (e.g., test servers).
+func baseURLRegion(baseURL string) (region string, ok bool) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", false
+	}
+	for name, known := range regionBaseURLs {
+		if k, _ := url.Parse(known); k != nil && strings.EqualFold(u.Host, k.Host) {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// maxConcurrency returns the configured fan-out limit, or the default for
+// clients built without Configure (e.g., in tests).
+func (c *Client) maxConcurrency() int {
+	if c.MaxConcurrency < 1 {
+		return defaultMaxConcurrency
+	}
+	return c.MaxConcurrency
 }
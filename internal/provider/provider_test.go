@@ -7,6 +7,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func TestProvider_Metadata_TypeName(t *testing.T) {
@@ -92,3 +94,58 @@ func TestProvider_FactoryLists_NotEmpty(t *testing.T) {
 		t.Fatal("Resources() must not be empty")
 	}
 }
+
+func TestBaseURLRegion(t *testing.T) {
+	cases := []struct {
+		in     string
+		region string
+		ok     bool
+	}{
+		{"https://api.sendgrid.com", "global", true},
+		{"https://api.eu.sendgrid.com/", "eu", true},
+		{"http://127.0.0.1:1234", "", false},
+	}
+	for _, c := range cases {
+		region, ok := baseURLRegion(c.in)
+		if region != c.region || ok != c.ok {
+			t.Errorf("baseURLRegion(%q) = %q, %t; want %q, %t", c.in, region, ok, c.region, c.ok)
+		}
+	}
+}
+
+func TestProvider_Configure_UnknownAPIKey(t *testing.T) {
+	p := &SendGridProvider{}
+	var schemaResp provider.SchemaResponse
+	p.Schema(context.Background(), provider.SchemaRequest{}, &schemaResp)
+
+	objType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	vals := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for name, typ := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	vals["api_key"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objType, vals)}
+
+	// With deferral support the whole provider is deferred.
+	var resp provider.ConfigureResponse
+	req := provider.ConfigureRequest{Config: config}
+	req.ClientCapabilities.DeferralAllowed = true
+	p.Configure(context.Background(), req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diags: %v", resp.Diagnostics)
+	}
+	if resp.Deferred == nil || resp.Deferred.Reason != provider.DeferredReasonProviderConfigUnknown {
+		t.Fatalf("expected a deferral, got %+v", resp.Deferred)
+	}
+	if resp.ResourceData != nil || resp.DataSourceData != nil {
+		t.Fatal("no client should be built from an unknown api_key")
+	}
+
+	// Without it, Configure warns and leaves the provider unconfigured.
+	resp = provider.ConfigureResponse{}
+	req.ClientCapabilities.DeferralAllowed = false
+	p.Configure(context.Background(), req, &resp)
+	if resp.Deferred != nil || resp.ResourceData != nil || resp.Diagnostics.WarningsCount() != 1 || resp.Diagnostics.HasError() {
+		t.Fatalf("expected a single warning and no client, got deferred=%v data=%v diags=%v", resp.Deferred, resp.ResourceData, resp.Diagnostics)
+	}
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sendgrid/sendgrid-go"
+)
+
+// NOTE: Per-run GET cache shared by every resource and data source.
+//
+// The client lives for one provider run (plan, apply, import), and during a run
+// many resources issue the same lookups (/v3/scopes, /v3/subusers pages, the
+// same teammate). cachedGet deduplicates them: concurrent identical requests
+// share one in-flight call, and successful responses are kept until the next
+// write. Only GETs without extra headers (e.g., on-behalf-of) go through it;
+// failures are never cached, so a later call retries.
+//
+// Any POST/PATCH/PUT/DELETE must call invalidateGetCache afterwards so reads
+// later in the run observe the change.
+
+// getCache maps a request key (see getCacheKey) to its response.
+type getCache struct {
+	mu      sync.Mutex
+	entries map[string]*getCacheEntry
+}
+
+// getResponse is the part of a sendgrid-go response kept in the cache.
+type getResponse struct {
+	StatusCode int
+	Body       string
+	Headers    map[string][]string
+}
+
+// getCacheEntry is one cached response; done is closed once it is filled in.
+type getCacheEntry struct {
+	done chan struct{}
+	resp getResponse
+	err  error
+}
+
+// getCacheKey identifies a GET by path and query. url.Values.Encode sorts the
+// keys, so the key does not depend on map iteration order.
+func getCacheKey(path string, query map[string]string) string {
+	q := make(url.Values, len(query))
+	for k, v := range query {
+		q.Set(k, v)
+	}
+	return "GET " + path + "?" + q.Encode()
+}
+
+// cachedGet performs GET path?query, or returns the response of an identical
+// earlier (or in-flight) request in this run.
+func (c *Client) cachedGet(ctx context.Context, path string, query map[string]string) (getResponse, error) {
+	key := getCacheKey(path, query)
+
+	c.gets.mu.Lock()
+	if c.gets.entries == nil {
+		c.gets.entries = make(map[string]*getCacheEntry)
+	}
+	if e, ok := c.gets.entries[key]; ok {
+		c.gets.mu.Unlock()
+		<-e.done
+		tflog.Trace(ctx, "GET served from request cache", map[string]any{"key": key})
+		return e.resp, e.err
+	}
+	e := &getCacheEntry{done: make(chan struct{})}
+	c.gets.entries[key] = e
+	c.gets.mu.Unlock()
+
+	reqSG := sendgrid.GetRequest(c.APIKey, path, c.BaseURL)
+	reqSG.Method = "GET"
+	if len(query) > 0 {
+		if reqSG.QueryParams == nil {
+			reqSG.QueryParams = make(map[string]string)
+		}
+		for k, v := range query {
+			reqSG.QueryParams[k] = v
+		}
+	}
+	sgResp, err := sendgrid.API(reqSG)
+	if err != nil {
+		e.err = err
+	} else {
+		e.resp = getResponse{StatusCode: sgResp.StatusCode, Body: sgResp.Body, Headers: sgResp.Headers}
+	}
+
+	// Waiters already holding e still get the failure; later calls retry.
+	if e.err != nil || e.resp.StatusCode >= 300 {
+		c.gets.mu.Lock()
+		if c.gets.entries[key] == e {
+			delete(c.gets.entries, key)
+		}
+		c.gets.mu.Unlock()
+	}
+	close(e.done)
+	return e.resp, e.err
+}
+
+// forgetGet drops the cached response of one request, e.g. before polling it.
+func (c *Client) forgetGet(path string, query map[string]string) {
+	c.gets.mu.Lock()
+	delete(c.gets.entries, getCacheKey(path, query))
+	c.gets.mu.Unlock()
+}
+
+// invalidateGetCache drops every cached response. Called after each write.
+func (c *Client) invalidateGetCache() {
+	c.gets.mu.Lock()
+	c.gets.entries = nil
+	c.gets.mu.Unlock()
+}
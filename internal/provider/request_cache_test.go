@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCachedGet_DeduplicatesUntilWrite(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, APIKey: "test-key"}
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = c.cachedGet(ctx, "/v3/subusers", map[string]string{"limit": "100", "offset": "0"})
+		}()
+	}
+	wg.Wait()
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Fatalf("identical GETs made %d calls, want 1", n)
+	}
+
+	_, _ = c.cachedGet(ctx, "/v3/subusers", map[string]string{"limit": "100", "offset": "100"})
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Fatalf("different query should not be served from cache, calls=%d", n)
+	}
+
+	c.invalidateGetCache()
+	_, _ = c.cachedGet(ctx, "/v3/subusers", map[string]string{"offset": "0", "limit": "100"})
+	if n := atomic.LoadInt32(&calls); n != 3 {
+		t.Fatalf("GET after invalidation should reach the API, calls=%d", n)
+	}
+}
+
+func TestCachedGet_DoesNotCacheFailures(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"scopes":[]}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, APIKey: "test-key"}
+	res, _ := c.cachedGet(context.Background(), "/v3/scopes", nil)
+	if res.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("first status = %d", res.StatusCode)
+	}
+	res, _ = c.cachedGet(context.Background(), "/v3/scopes", nil)
+	if res.StatusCode != http.StatusOK || atomic.LoadInt32(&calls) != 2 {
+		t.Fatalf("failed GET should be retried, status=%d calls=%d", res.StatusCode, calls)
+	}
+}
@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// NOTE: This resource manages which authenticated domain is the default
+// sending domain of the account or a subuser.
+//
+// API Endpoints:
+//   - Create/Update: PATCH /v3/whitelabel/domains/{domain_id}  {"default": true}
+//   - Read:          GET   /v3/whitelabel/domains/default
+//   - Delete:        PATCH /v3/whitelabel/domains/{domain_id}  {"default": false}
+//
+// API Documentation:
+//   - Get the default authentication:  https://www.twilio.com/docs/sendgrid/api-reference/domain-authentication/get-the-default-authentication
+//   - Update an authenticated domain:  https://www.twilio.com/docs/sendgrid/api-reference/domain-authentication/update-an-authenticated-domain
+//
+// There is one default per account, so the id and on_behalf_of handling of
+// settings.go are reused. Read records whichever domain is the default,
+// so flipping it in the UI shows up as a change of domain_id; when no domain
+// is the default any more, the resource is removed from state.
+
+var _ resource.Resource = (*DefaultDomainAuthenticationResource)(nil)
+var _ resource.ResourceWithConfigure = (*DefaultDomainAuthenticationResource)(nil)
+var _ resource.ResourceWithImportState = (*DefaultDomainAuthenticationResource)(nil)
+
+func NewDefaultDomainAuthenticationResource() resource.Resource {
+	return &DefaultDomainAuthenticationResource{}
+}
+
+type DefaultDomainAuthenticationResource struct{ client *Client }
+
+type defaultDomainAuthenticationModel struct {
+	ID         types.String `tfsdk:"id"`
+	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
+	DomainID   types.String `tfsdk:"domain_id"`
+	Domain     types.String `tfsdk:"domain"`
+}
+
+// authenticatedDomain is the subset of an authenticated domain used here.
+type authenticatedDomain struct {
+	ID      int64  `json:"id"`
+	Domain  string `json:"domain"`
+	Default bool   `json:"default"`
+}
+
+const defaultDomainAuthenticationName = "default authenticated domain"
+
+func (r *DefaultDomainAuthenticationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_default_domain_authentication"
+}
+
+func (r *DefaultDomainAuthenticationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pc, ok := req.ProviderData.(*Client)
+	if !ok || pc == nil {
+		resp.Diagnostics.AddError("Unexpected ProviderData",
+			"Expected *Client, got something else")
+		return
+	}
+	r.client = pc
+}
+
+func (r *DefaultDomainAuthenticationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Mark an authenticated domain as the default sending domain via `PATCH /v3/whitelabel/domains/{id}`. A default changed outside Terraform shows up as a change of `domain_id` on the next plan. Destroying the resource unsets the default.",
+		Attributes: map[string]schema.Attribute{
+			"domain_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the authenticated domain to make the default.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9]+$`), "must be a numeric authenticated domain ID"),
+				},
+			},
+			"domain": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Domain name of the default authenticated domain.",
+			},
+		},
+	}
+	for name, attr := range accountScopedAttributes() {
+		resp.Schema.Attributes[name] = attr
+	}
+}
+
+// Create marks the planned domain as the default.
+func (r *DefaultDomainAuthenticationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan defaultDomainAuthenticationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.setDefault(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read records the current default domain.
+func (r *DefaultDomainAuthenticationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	var state defaultDomainAuthenticationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	got, diags := r.client.readDefaultDomain(ctx, state.OnBehalfOf.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if got.ID == 0 {
+		tflog.Debug(ctx, "No default authenticated domain; removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(accountScopedID(state.OnBehalfOf.ValueString()))
+	state.DomainID = types.StringValue(strconv.FormatInt(got.ID, 10))
+	state.Domain = stringOrNull(got.Domain)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update marks the newly planned domain as the default.
+func (r *DefaultDomainAuthenticationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan defaultDomainAuthenticationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.setDefault(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete unsets the default, unless another domain has become the default in
+// the meantime.
+func (r *DefaultDomainAuthenticationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	resp.Diagnostics.Append(r.client.requireScopes(ctx, "unset default authenticated domain", "whitelabel.update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state defaultDomainAuthenticationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	onBehalfOf := state.OnBehalfOf.ValueString()
+	current, diags := r.client.readDefaultDomain(ctx, onBehalfOf)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if strconv.FormatInt(current.ID, 10) != state.DomainID.ValueString() {
+		return
+	}
+
+	var got authenticatedDomain
+	p := "/v3/whitelabel/domains/" + url.PathEscape(state.DomainID.ValueString())
+	resp.Diagnostics.Append(r.client.writeSetting(ctx, p, defaultDomainAuthenticationName, onBehalfOf, map[string]any{"default": false}, &got)...)
+}
+
+// ImportState allows `terraform import sendgrid_default_domain_authentication.example <account|subuser username>`.
+func (r *DefaultDomainAuthenticationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountScopedState(ctx, req, resp)
+}
+
+// setDefault PATCHes the domain in m to be the default and refreshes m from
+// the response.
+func (r *DefaultDomainAuthenticationResource) setDefault(ctx context.Context, m *defaultDomainAuthenticationModel, diags *diag.Diagnostics) {
+	if r.client == nil {
+		diags.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	diags.Append(r.client.requireScopes(ctx, "set default authenticated domain", "whitelabel.update")...)
+	if diags.HasError() {
+		return
+	}
+	var got authenticatedDomain
+	p := "/v3/whitelabel/domains/" + url.PathEscape(m.DomainID.ValueString())
+	diags.Append(r.client.writeSetting(ctx, p, defaultDomainAuthenticationName, m.OnBehalfOf.ValueString(), map[string]any{"default": true}, &got)...)
+	if diags.HasError() {
+		return
+	}
+	m.ID = types.StringValue(accountScopedID(m.OnBehalfOf.ValueString()))
+	m.Domain = stringOrNull(got.Domain)
+}
+
+// readDefaultDomain returns the default authenticated domain, with a zero ID
+// when there is none.
+// GET /v3/whitelabel/domains/default
+func (c *Client) readDefaultDomain(ctx context.Context, onBehalfOf string) (authenticatedDomain, diag.Diagnostics) {
+	var got authenticatedDomain
+	diags := c.settingRequest(ctx, "/v3/whitelabel/domains/default", defaultDomainAuthenticationName, onBehalfOf, nil, &got)
+	if diags.HasError() {
+		return authenticatedDomain{}, diags
+	}
+	if !got.Default {
+		// An empty object, or a domain not flagged as default, means no
+		// default is set.
+		got = authenticatedDomain{}
+	}
+	return got, diags
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDefaultDomainAuthentication_ReadDefault(t *testing.T) {
+	defaultID := int64(0)
+	var patched []map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v3/whitelabel/domains/default":
+			if defaultID == 0 {
+				_, _ = w.Write([]byte(`{}`))
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": defaultID, "domain": "example.com", "default": true})
+		case r.Method == http.MethodPatch && r.URL.Path == "/v3/whitelabel/domains/42":
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			patched = append(patched, body)
+			if body["default"] == true {
+				defaultID = 42
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": 42, "domain": "example.com", "default": body["default"]})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := &Client{BaseURL: srv.URL, APIKey: "test-key", SkipScopePreflight: true}
+
+	got, diags := c.readDefaultDomain(ctx, "")
+	if diags.HasError() || got.ID != 0 {
+		t.Fatalf("no default: got=%+v diags=%v", got, diags)
+	}
+
+	r := &DefaultDomainAuthenticationResource{client: c}
+	m := defaultDomainAuthenticationModel{DomainID: types.StringValue("42")}
+	r.setDefault(ctx, &m, &diags)
+	if diags.HasError() || m.ID.ValueString() != parentAccountID || m.Domain.ValueString() != "example.com" {
+		t.Fatalf("setDefault: m=%+v diags=%v", m, diags)
+	}
+	if len(patched) != 1 || patched[0]["default"] != true {
+		t.Fatalf("unexpected PATCH bodies: %v", patched)
+	}
+
+	got, diags = c.readDefaultDomain(ctx, "")
+	if diags.HasError() || got.ID != 42 {
+		t.Fatalf("default: got=%+v diags=%v", got, diags)
+	}
+}
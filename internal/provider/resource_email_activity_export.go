@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sendgrid/sendgrid-go"
+)
+
+// NOTE: Action-style resource that requests an Email Activity CSV export.
+//
+// API Endpoints:
+//   - Create: POST /v3/messages/download?query={query}
+//   - Read/Update/Delete: no API calls
+//
+// API Documentation:
+//   - Request CSV: https://www.twilio.com/docs/sendgrid/api-reference/email-activity/request-csv
+//
+// SendGrid only queues the export: the response carries no download ID, and
+// the link (with its download_uuid) is emailed to the account owner. The
+// sendgrid_email_activity_download data source turns that UUID into the
+// presigned URL.
+
+var _ resource.Resource = (*EmailActivityExportResource)(nil)
+var _ resource.ResourceWithConfigure = (*EmailActivityExportResource)(nil)
+
+func NewEmailActivityExportResource() resource.Resource { return &EmailActivityExportResource{} }
+
+type EmailActivityExportResource struct{ client *Client }
+
+type emailActivityExportModel struct {
+	ID          types.String `tfsdk:"id"`
+	Query       types.String `tfsdk:"query"`
+	Triggers    types.Map    `tfsdk:"triggers"`
+	Status      types.String `tfsdk:"status"`
+	Message     types.String `tfsdk:"message"`
+	RequestedAt types.String `tfsdk:"requested_at"`
+}
+
+func (r *EmailActivityExportResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_activity_export"
+}
+
+func (r *EmailActivityExportResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pc, ok := req.ProviderData.(*Client)
+	if !ok || pc == nil {
+		resp.Diagnostics.AddError("Unexpected ProviderData",
+			"Expected *Client, got something else")
+		return
+	}
+	r.client = pc
+}
+
+func (r *EmailActivityExportResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Request an Email Activity CSV export via `POST /v3/messages/download`. The export is requested on create; changing any argument (e.g. a value in `triggers`) requests it again. SendGrid emails the download link to the account owner; pass the `download_uuid` from that link to the `sendgrid_email_activity_download` data source to get the presigned URL. Destroying the resource makes no API call.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of this export request (the `requested_at` timestamp).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"query": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Email Activity query selecting the messages to export, e.g. `status=\"delivered\" AND last_event_time BETWEEN TIMESTAMP \"2025-01-01T00:00:00Z\" AND TIMESTAMP \"2025-01-31T23:59:59Z\"`. Omit to export every message.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Arbitrary values that request the export again when changed, e.g. an audit period.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Status returned by SendGrid for the request (e.g. `pending`).",
+			},
+			"message": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Message returned by SendGrid for the request.",
+			},
+			"requested_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "RFC 3339 timestamp of when the export was accepted by SendGrid.",
+			},
+		},
+	}
+}
+
+// Create requests the export.
+func (r *EmailActivityExportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	resp.Diagnostics.Append(r.client.requireScopes(ctx, "request email activity export", "messages.read")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan emailActivityExportModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queued, diags := r.client.requestEmailActivityExport(ctx, plan.Query.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requestedAt := time.Now().UTC().Format(time.RFC3339)
+	plan.Status = types.StringValue(queued.Status)
+	plan.Message = types.StringValue(queued.Message)
+	plan.RequestedAt = types.StringValue(requestedAt)
+	plan.ID = types.StringValue("email_activity_export@" + requestedAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the recorded state; there is nothing to refresh.
+func (r *EmailActivityExportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state emailActivityExportModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called with a diff, since every argument forces replacement.
+func (r *EmailActivityExportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan emailActivityExportModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from state.
+func (r *EmailActivityExportResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// emailActivityExportResponse is the body of POST /v3/messages/download.
+type emailActivityExportResponse struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// requestEmailActivityExport queues a CSV export of the messages matching query.
+// POST /v3/messages/download
+func (c *Client) requestEmailActivityExport(ctx context.Context, query string) (emailActivityExportResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var out emailActivityExportResponse
+
+	reqSG := sendgrid.GetRequest(c.APIKey, "/v3/messages/download", c.BaseURL)
+	reqSG.Method = "POST"
+	if query != "" {
+		if reqSG.QueryParams == nil {
+			reqSG.QueryParams = make(map[string]string)
+		}
+		reqSG.QueryParams["query"] = query
+	}
+
+	tflog.Debug(ctx, "POST /v3/messages/download", map[string]any{"query": query})
+
+	sgResp, err := sendgrid.API(reqSG)
+	c.invalidateGetCache()
+	if err != nil {
+		diags.AddError("SendGrid API error", err.Error())
+		return out, diags
+	}
+	diags.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+	if sgResp.StatusCode >= 300 {
+		diags.AddError(
+			fmt.Sprintf("Email Activity export failed: %s", apiErrorMessage(sgResp.Body)),
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+		return out, diags
+	}
+	if err := json.Unmarshal([]byte(sgResp.Body), &out); err != nil {
+		diags.AddError("Parse error (email activity export)", fmt.Sprintf("unable to parse body: %v", err))
+	}
+	return out, diags
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRequestEmailActivityExport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v3/messages/download" {
+			http.NotFound(w, r)
+			return
+		}
+		if got := r.URL.Query().Get("query"); got != `status="delivered"` {
+			t.Errorf("query = %q", got)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"status":"pending","message":"An email will be sent to the account owner."}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, APIKey: "test-key"}
+	got, diags := c.requestEmailActivityExport(context.Background(), `status="delivered"`)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if got.Status != "pending" || got.Message == "" {
+		t.Fatalf("unexpected response: %+v", got)
+	}
+}
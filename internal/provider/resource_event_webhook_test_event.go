@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sendgrid/sendgrid-go"
+)
+
+// NOTE: Action-style resource that sends a test event to an Event Webhook URL.
+//
+// API Endpoints:
+//   - Create: POST /v3/user/webhooks/event/test
+//   - URL lookup (url omitted): GET /v3/user/webhooks/event/settings/{webhook_id}
+//   - Read/Update/Delete: no API calls (the test event is fire-and-forget)
+//
+// API Documentation:
+//   - Test an Event Webhook: https://www.twilio.com/docs/sendgrid/api-reference/webhooks/test-an-event-webhooks-settings
+//   - Get an Event Webhook:  https://www.twilio.com/docs/sendgrid/api-reference/webhooks/get-an-event-webhook
+//
+// Every argument forces replacement, so a new test event is sent whenever the
+// URL or any value in `triggers` changes. When only `webhook_id` is given, the
+// event goes to the URL currently configured on that webhook, so CI can check
+// the deployed endpoint without repeating its URL.
+
+var _ resource.Resource = (*EventWebhookTestEventResource)(nil)
+var _ resource.ResourceWithConfigure = (*EventWebhookTestEventResource)(nil)
+
+func NewEventWebhookTestEventResource() resource.Resource { return &EventWebhookTestEventResource{} }
+
+type EventWebhookTestEventResource struct{ client *Client }
+
+type eventWebhookTestEventModel struct {
+	ID                types.String `tfsdk:"id"`
+	URL               types.String `tfsdk:"url"`
+	WebhookID         types.String `tfsdk:"webhook_id"`
+	OAuthClientID     types.String `tfsdk:"oauth_client_id"`
+	OAuthClientSecret types.String `tfsdk:"oauth_client_secret"`
+	OAuthTokenURL     types.String `tfsdk:"oauth_token_url"`
+	Triggers          types.Map    `tfsdk:"triggers"`
+	SentAt            types.String `tfsdk:"sent_at"`
+}
+
+func (r *EventWebhookTestEventResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_event_webhook_test_event"
+}
+
+func (r *EventWebhookTestEventResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pc, ok := req.ProviderData.(*Client)
+	if !ok || pc == nil {
+		resp.Diagnostics.AddError("Unexpected ProviderData",
+			"Expected *Client, got something else")
+		return
+	}
+	r.client = pc
+}
+
+func (r *EventWebhookTestEventResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	replace := []planmodifier.String{stringplanmodifier.RequiresReplace()}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Send a test event to an Event Webhook URL via `POST /v3/user/webhooks/event/test`. The event is sent on create and the apply fails with the API response when SendGrid rejects it; changing any argument (e.g. a value in `triggers`) sends it again. Destroying the resource makes no API call.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of this test run (the `url` and `sent_at` timestamp).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "URL the test event is POSTed to. When omitted, the URL configured on `webhook_id` is used and recorded here.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AtLeastOneOf(path.MatchRoot("webhook_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"webhook_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "ID of an existing Event Webhook whose settings (e.g. signing) apply to the test. Required when `url` is omitted; omit it otherwise to test with the account's legacy webhook settings.",
+				PlanModifiers:       replace,
+			},
+			"oauth_client_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "OAuth client ID used to obtain a token before sending the event.",
+				PlanModifiers:       replace,
+			},
+			"oauth_client_secret": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "OAuth client secret. Required by the API when `oauth_client_id` is set.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("oauth_client_id")),
+				},
+				PlanModifiers: replace,
+			},
+			"oauth_token_url": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "OAuth token URL. Required by the API when `oauth_client_id` is set.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("oauth_client_id")),
+				},
+				PlanModifiers: replace,
+			},
+			"triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Arbitrary values that send the test event again when changed, e.g. the `id` of the webhook configuration being verified.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"sent_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "RFC 3339 timestamp of when the test event was accepted by SendGrid.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type eventWebhookTestPayload struct {
+	ID                string `json:"id,omitempty"`
+	URL               string `json:"url"`
+	OAuthClientID     string `json:"oauth_client_id,omitempty"`
+	OAuthClientSecret string `json:"oauth_client_secret,omitempty"`
+	OAuthTokenURL     string `json:"oauth_token_url,omitempty"`
+}
+
+// Create sends the test event.
+// POST /v3/user/webhooks/event/test
+func (r *EventWebhookTestEventResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.requireScopes(ctx, "send test event webhook", "user.webhooks.event.test.create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan eventWebhookTestEventModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.URL.IsNull() || plan.URL.IsUnknown() {
+		var settings struct {
+			URL string `json:"url"`
+		}
+		p := "/v3/user/webhooks/event/settings/" + url.PathEscape(plan.WebhookID.ValueString())
+		if !r.client.getJSON(ctx, p, nil, &settings, &resp.Diagnostics) {
+			return
+		}
+		if settings.URL == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("webhook_id"), "Event Webhook has no URL",
+				fmt.Sprintf("Event Webhook %q has no URL configured; set url explicitly.", plan.WebhookID.ValueString()))
+			return
+		}
+		plan.URL = types.StringValue(settings.URL)
+	}
+
+	payload := eventWebhookTestPayload{
+		ID:                plan.WebhookID.ValueString(),
+		URL:               plan.URL.ValueString(),
+		OAuthClientID:     plan.OAuthClientID.ValueString(),
+		OAuthClientSecret: plan.OAuthClientSecret.ValueString(),
+		OAuthTokenURL:     plan.OAuthTokenURL.ValueString(),
+	}
+	b, _ := json.Marshal(payload)
+	reqSG := sendgrid.GetRequest(r.client.APIKey, "/v3/user/webhooks/event/test", r.client.BaseURL)
+	reqSG.Method = "POST"
+	reqSG.Body = b
+
+	tflog.Debug(ctx, "POST /v3/user/webhooks/event/test", map[string]any{"url": payload.URL})
+
+	sgResp, err := sendgrid.API(reqSG)
+	r.client.invalidateGetCache()
+	if err != nil {
+		resp.Diagnostics.AddError("SendGrid API error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+	if sgResp.StatusCode >= 300 {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Event Webhook test failed: %s", apiErrorMessage(sgResp.Body)),
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+		return
+	}
+
+	sentAt := time.Now().UTC().Format(time.RFC3339)
+	plan.SentAt = types.StringValue(sentAt)
+	plan.ID = types.StringValue(payload.URL + "@" + sentAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the recorded state; there is nothing to refresh.
+func (r *EventWebhookTestEventResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state eventWebhookTestEventModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called with a diff, since every argument forces replacement.
+func (r *EventWebhookTestEventResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan eventWebhookTestEventModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from state.
+func (r *EventWebhookTestEventResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
@@ -0,0 +1,122 @@
+package provider_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/diamond-cto/terraform-provider-sendgrid/internal/testacc"
+)
+
+// TestEventWebhookTestEventResource_mock checks that a test event is sent on
+// create and again when a trigger changes, and that API errors surface.
+func TestEventWebhookTestEventResource_mock(t *testing.T) {
+	var mu sync.Mutex
+	var urls []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v3/user/webhooks/event/test" {
+			writeErr(w, http.StatusNotFound, "not found", "")
+			return
+		}
+		var body struct {
+			URL string `json:"url"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body.URL == "https://unreachable.example" {
+			writeErr(w, http.StatusBadRequest, "unable to reach the webhook URL", "url")
+			return
+		}
+		mu.Lock()
+		urls = append(urls, body.URL)
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	config := func(url, version string) string {
+		return mockProviderConfig(srv.URL) + `
+resource "sendgrid_event_webhook_test_event" "test" {
+  url = "` + url + `"
+  triggers = {
+    version = "` + version + `"
+  }
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testacc.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("https://hooks.example/sendgrid", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sendgrid_event_webhook_test_event.test", "sent_at"),
+				),
+			},
+			{
+				Config: config("https://hooks.example/sendgrid", "2"),
+			},
+			{
+				Config:      config("https://unreachable.example", "2"),
+				ExpectError: regexp.MustCompile(`unable to reach the webhook URL`),
+			},
+		},
+	})
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(urls) != 2 {
+		t.Fatalf("expected 2 test events, got %d: %v", len(urls), urls)
+	}
+}
+
+// TestEventWebhookTestEventResource_webhookURL checks that the URL configured
+// on webhook_id is used when url is omitted.
+func TestEventWebhookTestEventResource_webhookURL(t *testing.T) {
+	var mu sync.Mutex
+	var sent []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v3/user/webhooks/event/settings/wh-1":
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "wh-1", "url": "https://deployed.example/events", "enabled": true})
+		case r.Method == http.MethodPost && r.URL.Path == "/v3/user/webhooks/event/test":
+			var body struct {
+				ID  string `json:"id"`
+				URL string `json:"url"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			mu.Lock()
+			sent = append(sent, body.ID+" "+body.URL)
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeErr(w, http.StatusNotFound, "not found", "")
+		}
+	}))
+	defer srv.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testacc.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: mockProviderConfig(srv.URL) + `
+resource "sendgrid_event_webhook_test_event" "test" {
+  webhook_id = "wh-1"
+}
+`,
+				Check: resource.TestCheckResourceAttr("sendgrid_event_webhook_test_event.test", "url", "https://deployed.example/events"),
+			},
+		},
+	})
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(sent) != 1 || sent[0] != "wh-1 https://deployed.example/events" {
+		t.Fatalf("unexpected test events: %v", sent)
+	}
+}
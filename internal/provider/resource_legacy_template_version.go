@@ -0,0 +1,384 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sendgrid/sendgrid-go"
+)
+
+// NOTE: This resource manages versions of legacy-generation transactional
+// templates.
+//
+// API Endpoints:
+//   - Create: POST   /v3/templates/{template_id}/versions
+//   - Read:   GET    /v3/templates/{template_id}/versions/{version_id}
+//   - Update: PATCH  /v3/templates/{template_id}/versions/{version_id}
+//   - Delete: DELETE /v3/templates/{template_id}/versions/{version_id}
+//
+// API Documentation:
+//   - Transactional Templates Versions: https://www.twilio.com/docs/sendgrid/api-reference/transactional-templates-versions
+//
+// Legacy templates wrap the message: the subject must contain <%subject%>
+// and the body <%body%>, which is checked at plan time. Activating a version
+// deactivates the template's other versions on the API side.
+
+var _ resource.Resource = (*LegacyTemplateVersionResource)(nil)
+var _ resource.ResourceWithConfigure = (*LegacyTemplateVersionResource)(nil)
+var _ resource.ResourceWithImportState = (*LegacyTemplateVersionResource)(nil)
+
+func NewLegacyTemplateVersionResource() resource.Resource { return &LegacyTemplateVersionResource{} }
+
+type LegacyTemplateVersionResource struct{ client *Client }
+
+type legacyTemplateVersionModel struct {
+	ID           types.String `tfsdk:"id"`
+	TemplateID   types.String `tfsdk:"template_id"`
+	Name         types.String `tfsdk:"name"`
+	Subject      types.String `tfsdk:"subject"`
+	HTMLContent  types.String `tfsdk:"html_content"`
+	PlainContent types.String `tfsdk:"plain_content"`
+	Active       types.Bool   `tfsdk:"active"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+}
+
+func (r *LegacyTemplateVersionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_legacy_template_version"
+}
+
+func (r *LegacyTemplateVersionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pc, ok := req.ProviderData.(*Client)
+	if !ok || pc == nil {
+		resp.Diagnostics.AddError("Unexpected ProviderData",
+			"Expected *Client, got something else")
+		return
+	}
+	r.client = pc
+}
+
+func (r *LegacyTemplateVersionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage a version of a legacy-generation transactional template via `/v3/templates/{template_id}/versions`. Legacy templates wrap the message, so `subject` must contain `<%subject%>` and the content `<%body%>`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Version ID returned by the API.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"template_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the legacy template this version belongs to. Changing it forces replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Version name.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"subject": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Subject line. Must contain `<%subject%>`, which is replaced by the message subject.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`<%subject%>`), "must contain <%subject%>"),
+				},
+			},
+			"html_content": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "HTML content. Must contain `<%body%>`, which is replaced by the message body.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`<%body%>`), "must contain <%body%>"),
+				},
+			},
+			"plain_content": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Plain text content. Must contain `<%body%>` when set. When omitted, the value stored by SendGrid is recorded.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`<%body%>`), "must contain <%body%>"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether this is the template's active version. Activating a version deactivates the template's other versions, which then show drift if they are managed with `active = true`.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Last update timestamp returned by the API.",
+			},
+		},
+	}
+}
+
+// ---------- API payloads ----------
+
+// legacyTemplateVersionPayload is sent on create and update. active is an
+// integer (0 or 1) in this API.
+type legacyTemplateVersionPayload struct {
+	Name         string  `json:"name"`
+	Subject      string  `json:"subject"`
+	HTMLContent  string  `json:"html_content"`
+	PlainContent *string `json:"plain_content,omitempty"`
+	Active       *int    `json:"active,omitempty"`
+}
+
+type legacyTemplateVersionResponse struct {
+	ID           string `json:"id"`
+	TemplateID   string `json:"template_id"`
+	Name         string `json:"name"`
+	Subject      string `json:"subject"`
+	HTMLContent  string `json:"html_content"`
+	PlainContent string `json:"plain_content"`
+	Active       int    `json:"active"`
+	UpdatedAt    string `json:"updated_at"`
+}
+
+func (m legacyTemplateVersionModel) payload() legacyTemplateVersionPayload {
+	p := legacyTemplateVersionPayload{
+		Name:        m.Name.ValueString(),
+		Subject:     m.Subject.ValueString(),
+		HTMLContent: m.HTMLContent.ValueString(),
+	}
+	if !m.PlainContent.IsNull() && !m.PlainContent.IsUnknown() {
+		v := m.PlainContent.ValueString()
+		p.PlainContent = &v
+	}
+	if !m.Active.IsNull() && !m.Active.IsUnknown() {
+		v := 0
+		if m.Active.ValueBool() {
+			v = 1
+		}
+		p.Active = &v
+	}
+	return p
+}
+
+func (m *legacyTemplateVersionModel) fromAPI(v legacyTemplateVersionResponse) {
+	m.ID = types.StringValue(v.ID)
+	m.TemplateID = types.StringValue(v.TemplateID)
+	m.Name = types.StringValue(v.Name)
+	m.Subject = types.StringValue(v.Subject)
+	m.HTMLContent = types.StringValue(v.HTMLContent)
+	m.PlainContent = types.StringValue(v.PlainContent)
+	m.Active = types.BoolValue(v.Active == 1)
+	m.UpdatedAt = types.StringValue(v.UpdatedAt)
+}
+
+// ---------- CRUD ----------
+
+// Create creates a version.
+// POST /v3/templates/{template_id}/versions
+func (r *LegacyTemplateVersionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	resp.Diagnostics.Append(r.client.requireScopes(ctx, "create template version", "templates.versions.create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var plan legacyTemplateVersionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := "/v3/templates/" + url.PathEscape(plan.TemplateID.ValueString()) + "/versions"
+	got, diags := r.client.writeLegacyTemplateVersion(ctx, true, p, plan.payload())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(legacyTemplatesWarning("POST", p)...)
+
+	plan.fromAPI(got)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read fetches a version.
+// GET /v3/templates/{template_id}/versions/{version_id}
+func (r *LegacyTemplateVersionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	var state legacyTemplateVersionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqSG := sendgrid.GetRequest(r.client.APIKey, legacyTemplateVersionPath(state), r.client.BaseURL)
+	reqSG.Method = "GET"
+	sgResp, err := sendgrid.API(reqSG)
+	if err != nil {
+		resp.Diagnostics.AddError("SendGrid API error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+	if sgResp.StatusCode == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if sgResp.StatusCode >= 300 {
+		resp.Diagnostics.AddError("Read template version failed",
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+		return
+	}
+	var got legacyTemplateVersionResponse
+	if err := json.Unmarshal([]byte(sgResp.Body), &got); err != nil {
+		resp.Diagnostics.AddError("Parse error (read template version)", fmt.Sprintf("unable to parse body: %v", err))
+		return
+	}
+	resp.Diagnostics.Append(legacyTemplatesWarning("GET", reqSG.BaseURL)...)
+
+	state.fromAPI(got)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update patches a version in place.
+// PATCH /v3/templates/{template_id}/versions/{version_id}
+func (r *LegacyTemplateVersionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	resp.Diagnostics.Append(r.client.requireScopes(ctx, "update template version", "templates.versions.update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var plan, state legacyTemplateVersionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	got, diags := r.client.writeLegacyTemplateVersion(ctx, false, legacyTemplateVersionPath(state), plan.payload())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.fromAPI(got)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes a version.
+// DELETE /v3/templates/{template_id}/versions/{version_id}
+func (r *LegacyTemplateVersionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	resp.Diagnostics.Append(r.client.requireScopes(ctx, "delete template version", "templates.versions.delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state legacyTemplateVersionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqSG := sendgrid.GetRequest(r.client.APIKey, legacyTemplateVersionPath(state), r.client.BaseURL)
+	reqSG.Method = "DELETE"
+	sgResp, err := sendgrid.API(reqSG)
+	r.client.invalidateGetCache()
+	if err != nil {
+		resp.Diagnostics.AddError("SendGrid API error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+	if sgResp.StatusCode >= 300 && sgResp.StatusCode != 404 {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Delete template version failed: %s", apiErrorMessage(sgResp.Body)),
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+	}
+}
+
+// ImportState allows `terraform import sendgrid_legacy_template_version.example <template_id>/<version_id>`.
+func (r *LegacyTemplateVersionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	templateID, versionID, ok := strings.Cut(req.ID, "/")
+	if !ok || templateID == "" || versionID == "" {
+		resp.Diagnostics.AddError("Invalid import ID",
+			fmt.Sprintf("expected <template_id>/<version_id>, got %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("template_id"), templateID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), versionID)...)
+}
+
+// legacyTemplatesWarning reminds users that legacy templates are a legacy API.
+func legacyTemplatesWarning(method, endpoint string) diag.Diagnostics {
+	return legacyEndpointWarning(method, endpoint, "dynamic transactional templates (generation = \"dynamic\")")
+}
+
+func legacyTemplateVersionPath(m legacyTemplateVersionModel) string {
+	return "/v3/templates/" + url.PathEscape(m.TemplateID.ValueString()) + "/versions/" + url.PathEscape(m.ID.ValueString())
+}
+
+// writeLegacyTemplateVersion sends a create (POST, create = true) or update
+// (PATCH) and decodes the returned version.
+func (c *Client) writeLegacyTemplateVersion(ctx context.Context, create bool, p string, payload legacyTemplateVersionPayload) (legacyTemplateVersionResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var out legacyTemplateVersionResponse
+
+	b, _ := json.Marshal(payload)
+	reqSG := sendgrid.GetRequest(c.APIKey, p, c.BaseURL)
+	reqSG.Method = "PATCH"
+	if create {
+		reqSG.Method = "POST"
+	}
+	reqSG.Body = b
+
+	tflog.Debug(ctx, string(reqSG.Method)+" "+p)
+
+	sgResp, err := sendgrid.API(reqSG)
+	c.invalidateGetCache()
+	if err != nil {
+		diags.AddError("SendGrid API error", err.Error())
+		return out, diags
+	}
+	diags.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+	if sgResp.StatusCode >= 300 {
+		diags.AddError(
+			fmt.Sprintf("Write template version failed: %s", apiErrorMessage(sgResp.Body)),
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+		return out, diags
+	}
+	if err := json.Unmarshal([]byte(sgResp.Body), &out); err != nil {
+		diags.AddError("Parse error (template version)", fmt.Sprintf("unable to parse body: %v", err))
+	}
+	return out, diags
+}
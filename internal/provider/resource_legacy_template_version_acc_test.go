@@ -0,0 +1,55 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/diamond-cto/terraform-provider-sendgrid/internal/testacc"
+)
+
+// TestAccLegacyTemplateVersion_basic creates, updates, and imports a version of
+// a throwaway legacy template.
+func TestAccLegacyTemplateVersion_basic(t *testing.T) {
+	templateID := testacc.LegacyTemplate(t)
+
+	config := func(name string) string {
+		return fmt.Sprintf(`
+resource "sendgrid_legacy_template_version" "test" {
+  template_id  = %q
+  name         = %q
+  subject      = "<%%subject%%>"
+  html_content = "<p><%%body%%></p>"
+}
+`, templateID, name)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testacc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testacc.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("v1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sendgrid_legacy_template_version.test", "id"),
+					resource.TestCheckResourceAttr("sendgrid_legacy_template_version.test", "name", "v1"),
+				),
+			},
+			{
+				Config: config("v2"),
+				Check:  resource.TestCheckResourceAttr("sendgrid_legacy_template_version.test", "name", "v2"),
+			},
+			{
+				ResourceName: "sendgrid_legacy_template_version.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["sendgrid_legacy_template_version.test"]
+					return templateID + "/" + rs.Primary.ID, nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
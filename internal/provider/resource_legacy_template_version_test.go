@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestLegacyTemplateVersion_WriteRoundTrip(t *testing.T) {
+	var got map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/v3/templates/tmpl-1/versions/v-1" {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		_, _ = w.Write([]byte(`{"id":"v-1","template_id":"tmpl-1","name":"v2","subject":"<%subject%>","html_content":"<p><%body%></p>","plain_content":"<%body%>","active":1,"updated_at":"2026-01-02 03:04:05"}`))
+	}))
+	defer srv.Close()
+
+	plan := legacyTemplateVersionModel{
+		ID:           types.StringValue("v-1"),
+		TemplateID:   types.StringValue("tmpl-1"),
+		Name:         types.StringValue("v2"),
+		Subject:      types.StringValue("<%subject%>"),
+		HTMLContent:  types.StringValue("<p><%body%></p>"),
+		PlainContent: types.StringUnknown(),
+		Active:       types.BoolValue(true),
+	}
+	c := &Client{BaseURL: srv.URL, APIKey: "test-key"}
+	res, diags := c.writeLegacyTemplateVersion(context.Background(), false, legacyTemplateVersionPath(plan), plan.payload())
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if got["active"] != float64(1) {
+		t.Fatalf("active sent as %v, want 1", got["active"])
+	}
+	if _, ok := got["plain_content"]; ok {
+		t.Fatalf("unknown plain_content must not be sent: %v", got)
+	}
+
+	plan.fromAPI(res)
+	if !plan.Active.ValueBool() || plan.PlainContent.ValueString() != "<%body%>" {
+		t.Fatalf("unexpected model: %+v", plan)
+	}
+}
@@ -0,0 +1,397 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sendgrid/sendgrid-go"
+)
+
+// NOTE: This resource places an existing Marketing Campaigns contact into a
+// list.
+//
+// API Endpoints:
+//   - Create: POST   /v3/marketing/contacts/search/emails, then PUT /v3/marketing/contacts  {"list_ids": [...], "contacts": [{"email": ...}]},
+//             then GET /v3/marketing/contacts/imports/{job_id} until the job completes
+//   - Read:   POST   /v3/marketing/contacts/search/emails
+//   - Delete: DELETE /v3/marketing/lists/{list_id}/contacts?contact_ids={contact_id}
+//
+// API Documentation:
+//   - Get Contacts by Emails: https://www.twilio.com/docs/sendgrid/api-reference/contacts/get-contacts-by-emails
+//   - Add or Update a Contact: https://www.twilio.com/docs/sendgrid/api-reference/contacts/add-or-update-a-contact
+//   - Import Contacts Status: https://www.twilio.com/docs/sendgrid/api-reference/contacts/import-contacts-status
+//   - Remove Contacts from a List: https://www.twilio.com/docs/sendgrid/api-reference/lists/remove-contacts-from-a-list
+//
+// Adding and removing are queued as jobs by SendGrid. Create waits for the
+// add job to complete, since Read drops the resource while the contact is not
+// yet in list_ids; removal is not waited for. The upsert only names the email,
+// so the contact's other fields and lists are left as they are.
+
+// contactsImportPollInterval is the delay between contact job status polls.
+// A variable so tests can shorten it.
+var contactsImportPollInterval = 5 * time.Second
+
+// contactsImportTimeout bounds waiting for a contact job.
+const contactsImportTimeout = 10 * time.Minute
+
+var _ resource.Resource = (*ListMembershipResource)(nil)
+var _ resource.ResourceWithConfigure = (*ListMembershipResource)(nil)
+var _ resource.ResourceWithImportState = (*ListMembershipResource)(nil)
+
+func NewListMembershipResource() resource.Resource { return &ListMembershipResource{} }
+
+type ListMembershipResource struct{ client *Client }
+
+type listMembershipModel struct {
+	ID        types.String `tfsdk:"id"`
+	ListID    types.String `tfsdk:"list_id"`
+	Email     types.String `tfsdk:"email"`
+	ContactID types.String `tfsdk:"contact_id"`
+}
+
+func (r *ListMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_list_membership"
+}
+
+func (r *ListMembershipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pc, ok := req.ProviderData.(*Client)
+	if !ok || pc == nil {
+		resp.Diagnostics.AddError("Unexpected ProviderData",
+			"Expected *Client, got something else")
+		return
+	}
+	r.client = pc
+}
+
+func (r *ListMembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	replace := []planmodifier.String{stringplanmodifier.RequiresReplace()}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Place an existing Marketing Campaigns contact into a list, and remove it from the list on destroy. The contact itself is neither created nor deleted. Create waits for SendGrid to finish adding the contact, which can take a few minutes; removal on destroy is applied asynchronously.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "`<list_id>/<email>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"list_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the list. Changing it forces replacement.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: replace,
+			},
+			"email": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Email address of the contact, which must already exist. Changing it forces replacement.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(3),
+				},
+				PlanModifiers: replace,
+			},
+			"contact_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the contact.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create adds the contact to the list.
+func (r *ListMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	resp.Diagnostics.Append(r.client.requireScopes(ctx, "add contact to list", "mc.contacts.update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var plan listMembershipModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	email := plan.Email.ValueString()
+	contact, found, diags := r.client.contactByEmail(ctx, email)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddAttributeError(path.Root("email"), "Contact not found",
+			fmt.Sprintf("No Marketing Campaigns contact has the email address %q. Create the contact before adding it to a list.", email))
+		return
+	}
+
+	jobID, diags := r.client.addContactToList(ctx, plan.ListID.ValueString(), email)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if jobID != "" {
+		resp.Diagnostics.Append(r.client.waitForContactsImport(ctx, jobID, contactsImportTimeout)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.ContactID = types.StringValue(contact.ID)
+	plan.ID = types.StringValue(plan.ListID.ValueString() + "/" + email)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read removes the resource from state when the contact is gone or no longer
+// in the list.
+func (r *ListMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	var state listMembershipModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contact, found, diags := r.client.contactByEmail(ctx, state.Email.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found || !slices.Contains(contact.ListIDs, state.ListID.ValueString()) {
+		tflog.Debug(ctx, "Contact is not in the list; removing from state", map[string]any{"list_id": state.ListID.ValueString(), "found": found})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ContactID = types.StringValue(contact.ID)
+	state.ID = types.StringValue(state.ListID.ValueString() + "/" + state.Email.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called with a diff, since every argument forces replacement.
+func (r *ListMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan listMembershipModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the contact from the list.
+func (r *ListMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	resp.Diagnostics.Append(r.client.requireScopes(ctx, "remove contact from list", "mc.lists.update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state listMembershipModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.client.removeContactFromList(ctx, state.ListID.ValueString(), state.ContactID.ValueString())...)
+}
+
+// ImportState allows `terraform import sendgrid_list_membership.example <list_id>/<email>`.
+func (r *ListMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	listID, email, ok := strings.Cut(req.ID, "/")
+	if !ok || listID == "" || email == "" {
+		resp.Diagnostics.AddError("Invalid import ID",
+			fmt.Sprintf("expected <list_id>/<email>, got %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("list_id"), listID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), email)...)
+}
+
+// contactByEmail looks a contact up by email address; found is false when
+// there is no such contact.
+// POST /v3/marketing/contacts/search/emails
+func (c *Client) contactByEmail(ctx context.Context, email string) (contactsSearchContact, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	b, _ := json.Marshal(map[string][]string{"emails": {email}})
+	reqSG := sendgrid.GetRequest(c.APIKey, "/v3/marketing/contacts/search/emails", c.BaseURL)
+	reqSG.Method = "POST"
+	reqSG.Body = b
+
+	tflog.Debug(ctx, "POST /v3/marketing/contacts/search/emails", map[string]any{"email": email})
+
+	sgResp, err := sendgrid.API(reqSG)
+	if err != nil {
+		diags.AddError("SendGrid API error", err.Error())
+		return contactsSearchContact{}, false, diags
+	}
+	diags.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+	if sgResp.StatusCode == 404 {
+		return contactsSearchContact{}, false, diags
+	}
+	if sgResp.StatusCode >= 300 {
+		diags.AddError(
+			fmt.Sprintf("Get contact by email failed: %s", apiErrorMessage(sgResp.Body)),
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+		return contactsSearchContact{}, false, diags
+	}
+
+	var got struct {
+		Result map[string]struct {
+			Contact *contactsSearchContact `json:"contact"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal([]byte(sgResp.Body), &got); err != nil {
+		diags.AddError("Parse error (contact by email)", fmt.Sprintf("unable to parse body: %v", err))
+		return contactsSearchContact{}, false, diags
+	}
+	// Emails are matched case-insensitively and returned as stored.
+	for k, v := range got.Result {
+		if strings.EqualFold(k, email) && v.Contact != nil {
+			return *v.Contact, true, diags
+		}
+	}
+	return contactsSearchContact{}, false, diags
+}
+
+// addContactToList queues adding the contact with email to the list and
+// returns the ID of the queued job.
+// PUT /v3/marketing/contacts
+func (c *Client) addContactToList(ctx context.Context, listID, email string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	b, _ := json.Marshal(map[string]any{
+		"list_ids": []string{listID},
+		"contacts": []map[string]string{{"email": email}},
+	})
+	reqSG := sendgrid.GetRequest(c.APIKey, "/v3/marketing/contacts", c.BaseURL)
+	reqSG.Method = "PUT"
+	reqSG.Body = b
+
+	tflog.Debug(ctx, "PUT /v3/marketing/contacts", map[string]any{"list_id": listID, "email": email})
+
+	sgResp, err := sendgrid.API(reqSG)
+	c.invalidateGetCache()
+	if err != nil {
+		diags.AddError("SendGrid API error", err.Error())
+		return "", diags
+	}
+	diags.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+	if sgResp.StatusCode >= 300 {
+		diags.AddError(
+			fmt.Sprintf("Add contact to list failed: %s", apiErrorMessage(sgResp.Body)),
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+		return "", diags
+	}
+	var queued struct {
+		JobID string `json:"job_id"`
+	}
+	if err := json.Unmarshal([]byte(sgResp.Body), &queued); err != nil {
+		diags.AddError("Parse error (add contact to list)", fmt.Sprintf("unable to parse body: %v", err))
+		return "", diags
+	}
+	return queued.JobID, diags
+}
+
+// contactsImportStatus is the subset of a contact job status used here.
+type contactsImportStatus struct {
+	Status  string `json:"status"`
+	Results struct {
+		ErroredCount int64 `json:"errored_count"`
+	} `json:"results"`
+}
+
+// waitForContactsImport polls a contact job until it completes, errors when
+// the job fails, or gives up once timeout elapses.
+// GET /v3/marketing/contacts/imports/{id}
+func (c *Client) waitForContactsImport(ctx context.Context, id string, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+	p := "/v3/marketing/contacts/imports/" + url.PathEscape(id)
+	deadline := time.Now().Add(timeout)
+	for {
+		var job contactsImportStatus
+		// Every poll must reach the API rather than the GET cache.
+		c.forgetGet(p, nil)
+		if !c.getJSON(ctx, p, nil, &job, &diags) {
+			return diags
+		}
+		tflog.Debug(ctx, "Waiting for contacts job", map[string]any{"job_id": id, "status": job.Status})
+		switch job.Status {
+		case "completed":
+			if job.Results.ErroredCount > 0 {
+				diags.AddError("Contacts job failed",
+					fmt.Sprintf("job %s completed with %d errored contacts", id, job.Results.ErroredCount))
+			}
+			return diags
+		case "failed", "errored":
+			diags.AddError("Contacts job failed", fmt.Sprintf("job %s ended with status %q", id, job.Status))
+			return diags
+		}
+		if time.Now().Add(contactsImportPollInterval).After(deadline) {
+			diags.AddError("Timed out waiting for contacts job",
+				fmt.Sprintf("job %s was still %q after %s", id, job.Status, timeout))
+			return diags
+		}
+		select {
+		case <-ctx.Done():
+			diags.AddError("Canceled waiting for contacts job", ctx.Err().Error())
+			return diags
+		case <-time.After(contactsImportPollInterval):
+		}
+	}
+}
+
+// removeContactFromList queues removing the contact from the list. A list
+// that is already gone is not an error.
+// DELETE /v3/marketing/lists/{list_id}/contacts?contact_ids={contact_id}
+func (c *Client) removeContactFromList(ctx context.Context, listID, contactID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	reqSG := sendgrid.GetRequest(c.APIKey, "/v3/marketing/lists/"+url.PathEscape(listID)+"/contacts", c.BaseURL)
+	reqSG.Method = "DELETE"
+	reqSG.QueryParams = map[string]string{"contact_ids": contactID}
+
+	tflog.Debug(ctx, "DELETE /v3/marketing/lists/{list_id}/contacts", map[string]any{"list_id": listID, "contact_id": contactID})
+
+	sgResp, err := sendgrid.API(reqSG)
+	c.invalidateGetCache()
+	if err != nil {
+		diags.AddError("SendGrid API error", err.Error())
+		return diags
+	}
+	diags.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+	if sgResp.StatusCode >= 300 && sgResp.StatusCode != 404 {
+		diags.AddError(
+			fmt.Sprintf("Remove contact from list failed: %s", apiErrorMessage(sgResp.Body)),
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+	}
+	return diags
+}
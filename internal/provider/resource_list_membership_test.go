@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListMembershipClient(t *testing.T) {
+	prev := contactsImportPollInterval
+	contactsImportPollInterval = time.Millisecond
+	defer func() { contactsImportPollInterval = prev }()
+
+	var calls []string
+	var upsert map[string]any
+	polls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.RequestURI())
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v3/marketing/contacts/search/emails":
+			var body map[string][]string
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body["emails"][0] != "bot@example.com" {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"errors":[{"message":"No results found"}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"result":{"Bot@example.com":{"contact":{"id":"c1","email":"Bot@example.com","list_ids":["l1","l2"]}}}}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/v3/marketing/contacts":
+			_ = json.NewDecoder(r.Body).Decode(&upsert)
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"job_id":"job-1"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v3/marketing/contacts/imports/job-1":
+			polls++
+			status := "pending"
+			if polls == 3 {
+				status = "completed"
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "job-1", "status": status})
+		case r.Method == http.MethodGet && r.URL.Path == "/v3/marketing/contacts/imports/job-3":
+			_, _ = w.Write([]byte(`{"id":"job-3","status":"failed"}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/v3/marketing/lists/l1/contacts":
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"job_id":"job-2"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := &Client{BaseURL: srv.URL, APIKey: "test-key"}
+
+	contact, found, diags := c.contactByEmail(ctx, "bot@example.com")
+	if diags.HasError() || !found || contact.ID != "c1" || !reflect.DeepEqual(contact.ListIDs, []string{"l1", "l2"}) {
+		t.Fatalf("unexpected contact: %+v found=%v diags=%v", contact, found, diags)
+	}
+	if _, found, diags := c.contactByEmail(ctx, "nobody@example.com"); diags.HasError() || found {
+		t.Fatalf("expected not found, got found=%v diags=%v", found, diags)
+	}
+
+	jobID, diags := c.addContactToList(ctx, "l1", "bot@example.com")
+	if diags.HasError() || jobID != "job-1" {
+		t.Fatalf("unexpected job %q, diags: %v", jobID, diags)
+	}
+	want := map[string]any{"list_ids": []any{"l1"}, "contacts": []any{map[string]any{"email": "bot@example.com"}}}
+	if !reflect.DeepEqual(upsert, want) {
+		t.Fatalf("got upsert %v, want %v", upsert, want)
+	}
+
+	if diags := c.waitForContactsImport(ctx, "job-1", time.Minute); diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if polls != 3 {
+		t.Fatalf("expected 3 polls, got %d", polls)
+	}
+	if diags := c.waitForContactsImport(ctx, "job-3", time.Minute); !diags.HasError() {
+		t.Fatal("expected failed job error")
+	}
+
+	if diags := c.removeContactFromList(ctx, "l1", "c1"); diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if last := calls[len(calls)-1]; last != "DELETE /v3/marketing/lists/l1/contacts?contact_ids=c1" {
+		t.Fatalf("unexpected delete call %q", last)
+	}
+}
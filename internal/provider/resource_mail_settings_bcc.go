@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NOTE: This resource manages the BCC mail setting of the account or a subuser.
+//
+// API Endpoints:
+//   - Create/Update: PATCH /v3/mail_settings/bcc
+//   - Read:          GET   /v3/mail_settings/bcc
+//   - Delete:        PATCH /v3/mail_settings/bcc  {"enabled": false}
+//
+// API Documentation:
+//   - Update BCC mail settings: https://www.twilio.com/docs/sendgrid/api-reference/settings-mail/update-bcc-mail-settings
+//
+// See mail_settings.go and settings.go for the shared request and import handling.
+
+var _ resource.Resource = (*MailSettingsBCCResource)(nil)
+var _ resource.ResourceWithConfigure = (*MailSettingsBCCResource)(nil)
+var _ resource.ResourceWithImportState = (*MailSettingsBCCResource)(nil)
+
+func NewMailSettingsBCCResource() resource.Resource { return &MailSettingsBCCResource{} }
+
+type MailSettingsBCCResource struct{ client *Client }
+
+type mailSettingsBCCModel struct {
+	ID         types.String `tfsdk:"id"`
+	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	Email      types.String `tfsdk:"email"`
+}
+
+// mailSettingsBCCAPI is the body of GET and PATCH /v3/mail_settings/bcc.
+type mailSettingsBCCAPI struct {
+	Enabled bool   `json:"enabled"`
+	Email   string `json:"email,omitempty"`
+}
+
+func (r *MailSettingsBCCResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mail_settings_bcc"
+}
+
+func (r *MailSettingsBCCResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pc, ok := req.ProviderData.(*Client)
+	if !ok || pc == nil {
+		resp.Diagnostics.AddError("Unexpected ProviderData",
+			"Expected *Client, got something else")
+		return
+	}
+	r.client = pc
+}
+
+func (r *MailSettingsBCCResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the BCC mail setting via `/v3/mail_settings/bcc`: when enabled, a blind copy of every email is sent to `email`. The setting always exists, so destroying the resource disables it.",
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Required:            true,
+				MarkdownDescription: "Whether every email is blind-copied to `email`.",
+			},
+			"email": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Address that receives the blind copies. Required by the API when `enabled = true`. When omitted, the address stored by SendGrid is recorded.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+	for name, attr := range accountScopedAttributes() {
+		resp.Schema.Attributes[name] = attr
+	}
+}
+
+func (m mailSettingsBCCModel) payload() mailSettingsBCCAPI {
+	return mailSettingsBCCAPI{Enabled: m.Enabled.ValueBool(), Email: m.Email.ValueString()}
+}
+
+func (m *mailSettingsBCCModel) fromAPI(v mailSettingsBCCAPI) {
+	m.ID = types.StringValue(accountScopedID(m.OnBehalfOf.ValueString()))
+	m.Enabled = types.BoolValue(v.Enabled)
+	m.Email = stringOrNull(v.Email)
+}
+
+// Create applies the planned setting.
+func (r *MailSettingsBCCResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan mailSettingsBCCModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.write(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read fetches the current setting.
+func (r *MailSettingsBCCResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	var state mailSettingsBCCModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var got mailSettingsBCCAPI
+	resp.Diagnostics.Append(r.client.readMailSetting(ctx, "bcc", state.OnBehalfOf.ValueString(), &got)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.fromAPI(got)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update applies the planned setting.
+func (r *MailSettingsBCCResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan mailSettingsBCCModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.write(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete disables the setting.
+func (r *MailSettingsBCCResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	resp.Diagnostics.Append(r.client.requireScopes(ctx, "disable BCC mail setting", "mail_settings.bcc.update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state mailSettingsBCCModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var got mailSettingsBCCAPI
+	resp.Diagnostics.Append(r.client.writeMailSetting(ctx, "bcc", state.OnBehalfOf.ValueString(), map[string]any{"enabled": false}, &got)...)
+}
+
+// ImportState allows `terraform import sendgrid_mail_settings_bcc.example <account|subuser username>`.
+func (r *MailSettingsBCCResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountScopedState(ctx, req, resp)
+}
+
+// write PATCHes the setting from m and refreshes m from the response.
+func (r *MailSettingsBCCResource) write(ctx context.Context, m *mailSettingsBCCModel, diags *diag.Diagnostics) {
+	if r.client == nil {
+		diags.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	diags.Append(r.client.requireScopes(ctx, "update BCC mail setting", "mail_settings.bcc.update")...)
+	if diags.HasError() {
+		return
+	}
+	var got mailSettingsBCCAPI
+	diags.Append(r.client.writeMailSetting(ctx, "bcc", m.OnBehalfOf.ValueString(), m.payload(), &got)...)
+	if diags.HasError() {
+		return
+	}
+	m.fromAPI(got)
+}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NOTE: This resource manages the spam check mail setting of the account or a
+// subuser.
+//
+// API Endpoints:
+//   - Create/Update: PATCH /v3/mail_settings/spam_check
+//   - Read:          GET   /v3/mail_settings/spam_check
+//   - Delete:        PATCH /v3/mail_settings/spam_check  {"enabled": false}
+//
+// API Documentation:
+//   - Update spam check mail settings: https://www.twilio.com/docs/sendgrid/api-reference/settings-mail/update-spam-check-mail-settings
+//
+// See mail_settings.go and settings.go for the shared request and import handling.
+
+var _ resource.Resource = (*MailSettingsSpamCheckResource)(nil)
+var _ resource.ResourceWithConfigure = (*MailSettingsSpamCheckResource)(nil)
+var _ resource.ResourceWithImportState = (*MailSettingsSpamCheckResource)(nil)
+
+func NewMailSettingsSpamCheckResource() resource.Resource { return &MailSettingsSpamCheckResource{} }
+
+type MailSettingsSpamCheckResource struct{ client *Client }
+
+type mailSettingsSpamCheckModel struct {
+	ID         types.String `tfsdk:"id"`
+	OnBehalfOf types.String `tfsdk:"on_behalf_of"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	MaxScore   types.Int64  `tfsdk:"max_score"`
+	URL        types.String `tfsdk:"url"`
+}
+
+// mailSettingsSpamCheckAPI is the body of GET and PATCH /v3/mail_settings/spam_check.
+type mailSettingsSpamCheckAPI struct {
+	Enabled  bool   `json:"enabled"`
+	MaxScore *int64 `json:"max_score,omitempty"`
+	URL      string `json:"url,omitempty"`
+}
+
+func (r *MailSettingsSpamCheckResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mail_settings_spam_check"
+}
+
+func (r *MailSettingsSpamCheckResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pc, ok := req.ProviderData.(*Client)
+	if !ok || pc == nil {
+		resp.Diagnostics.AddError("Unexpected ProviderData",
+			"Expected *Client, got something else")
+		return
+	}
+	r.client = pc
+}
+
+func (r *MailSettingsSpamCheckResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the spam check mail setting via `/v3/mail_settings/spam_check`: when enabled, emails scoring at least `max_score` are dropped and, if `url` is set, POSTed there. The setting always exists, so destroying the resource disables it.",
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Required:            true,
+				MarkdownDescription: "Whether outbound emails are checked for spam.",
+			},
+			"max_score": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Spam score threshold from 1 (strictest) to 10. When omitted, the threshold stored by SendGrid is recorded.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Inbound Parse URL that receives a copy of each email flagged as spam. When omitted, the URL stored by SendGrid is recorded.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+	for name, attr := range accountScopedAttributes() {
+		resp.Schema.Attributes[name] = attr
+	}
+}
+
+func (m mailSettingsSpamCheckModel) payload() mailSettingsSpamCheckAPI {
+	p := mailSettingsSpamCheckAPI{Enabled: m.Enabled.ValueBool(), URL: m.URL.ValueString()}
+	if !m.MaxScore.IsNull() && !m.MaxScore.IsUnknown() {
+		v := m.MaxScore.ValueInt64()
+		p.MaxScore = &v
+	}
+	return p
+}
+
+func (m *mailSettingsSpamCheckModel) fromAPI(v mailSettingsSpamCheckAPI) {
+	m.ID = types.StringValue(accountScopedID(m.OnBehalfOf.ValueString()))
+	m.Enabled = types.BoolValue(v.Enabled)
+	m.MaxScore = types.Int64PointerValue(v.MaxScore)
+	m.URL = stringOrNull(v.URL)
+}
+
+// Create applies the planned setting.
+func (r *MailSettingsSpamCheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan mailSettingsSpamCheckModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.write(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read fetches the current setting.
+func (r *MailSettingsSpamCheckResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	var state mailSettingsSpamCheckModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var got mailSettingsSpamCheckAPI
+	resp.Diagnostics.Append(r.client.readMailSetting(ctx, "spam_check", state.OnBehalfOf.ValueString(), &got)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.fromAPI(got)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update applies the planned setting.
+func (r *MailSettingsSpamCheckResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan mailSettingsSpamCheckModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.write(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete disables the setting.
+func (r *MailSettingsSpamCheckResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	resp.Diagnostics.Append(r.client.requireScopes(ctx, "disable spam check mail setting", "mail_settings.spam_check.update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state mailSettingsSpamCheckModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var got mailSettingsSpamCheckAPI
+	resp.Diagnostics.Append(r.client.writeMailSetting(ctx, "spam_check", state.OnBehalfOf.ValueString(), map[string]any{"enabled": false}, &got)...)
+}
+
+// ImportState allows `terraform import sendgrid_mail_settings_spam_check.example <account|subuser username>`.
+func (r *MailSettingsSpamCheckResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountScopedState(ctx, req, resp)
+}
+
+// write PATCHes the setting from m and refreshes m from the response.
+func (r *MailSettingsSpamCheckResource) write(ctx context.Context, m *mailSettingsSpamCheckModel, diags *diag.Diagnostics) {
+	if r.client == nil {
+		diags.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	diags.Append(r.client.requireScopes(ctx, "update spam check mail setting", "mail_settings.spam_check.update")...)
+	if diags.HasError() {
+		return
+	}
+	var got mailSettingsSpamCheckAPI
+	diags.Append(r.client.writeMailSetting(ctx, "spam_check", m.OnBehalfOf.ValueString(), m.payload(), &got)...)
+	if diags.HasError() {
+		return
+	}
+	m.fromAPI(got)
+}
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NOTE: This resource manages the legacy template mail setting of the account
+// or a subuser: an HTML wrapper applied around the content of every email.
+//
+// API Endpoints:
+//   - Create/Update: PATCH /v3/mail_settings/template
+//   - Read:          GET   /v3/mail_settings/template
+//   - Delete:        PATCH /v3/mail_settings/template  {"enabled": false}
+//
+// API Documentation:
+//   - Update legacy template mail settings: https://www.twilio.com/docs/sendgrid/api-reference/settings-mail/update-legacy-template-mail-settings
+//
+// See mail_settings.go and settings.go for the shared request and import handling.
+
+// legacyTemplateBodyTag matches the placeholder SendGrid replaces with the
+// content of each email.
+var legacyTemplateBodyTag = regexp.MustCompile(`<%\s*body\s*%>`)
+
+var _ resource.Resource = (*MailSettingsTemplateResource)(nil)
+var _ resource.ResourceWithConfigure = (*MailSettingsTemplateResource)(nil)
+var _ resource.ResourceWithImportState = (*MailSettingsTemplateResource)(nil)
+
+func NewMailSettingsTemplateResource() resource.Resource { return &MailSettingsTemplateResource{} }
+
+type MailSettingsTemplateResource struct{ client *Client }
+
+type mailSettingsTemplateModel struct {
+	ID          types.String `tfsdk:"id"`
+	OnBehalfOf  types.String `tfsdk:"on_behalf_of"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	HTMLContent types.String `tfsdk:"html_content"`
+}
+
+// mailSettingsTemplateAPI is the body of GET and PATCH /v3/mail_settings/template.
+type mailSettingsTemplateAPI struct {
+	Enabled     bool   `json:"enabled"`
+	HTMLContent string `json:"html_content,omitempty"`
+}
+
+func (r *MailSettingsTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mail_settings_template"
+}
+
+func (r *MailSettingsTemplateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pc, ok := req.ProviderData.(*Client)
+	if !ok || pc == nil {
+		resp.Diagnostics.AddError("Unexpected ProviderData",
+			"Expected *Client, got something else")
+		return
+	}
+	r.client = pc
+}
+
+func (r *MailSettingsTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the legacy template mail setting via `/v3/mail_settings/template`: when enabled, the content of every email is wrapped in `html_content`. Intended for accounts still relying on the legacy wrapper; new setups should use dynamic templates. The setting always exists, so destroying the resource disables it.",
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Required:            true,
+				MarkdownDescription: "Whether every email is wrapped in `html_content`.",
+			},
+			"html_content": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "HTML wrapper. Must contain the `<% body %>` tag, which is replaced by the content of each email. When omitted, the wrapper stored by SendGrid is recorded.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(legacyTemplateBodyTag, "must contain the <% body %> tag"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+	for name, attr := range accountScopedAttributes() {
+		resp.Schema.Attributes[name] = attr
+	}
+}
+
+func (m mailSettingsTemplateModel) payload() mailSettingsTemplateAPI {
+	return mailSettingsTemplateAPI{Enabled: m.Enabled.ValueBool(), HTMLContent: m.HTMLContent.ValueString()}
+}
+
+func (m *mailSettingsTemplateModel) fromAPI(v mailSettingsTemplateAPI) {
+	m.ID = types.StringValue(accountScopedID(m.OnBehalfOf.ValueString()))
+	m.Enabled = types.BoolValue(v.Enabled)
+	m.HTMLContent = stringOrNull(v.HTMLContent)
+}
+
+// Create applies the planned setting.
+func (r *MailSettingsTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan mailSettingsTemplateModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.write(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read fetches the current setting.
+func (r *MailSettingsTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	var state mailSettingsTemplateModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var got mailSettingsTemplateAPI
+	resp.Diagnostics.Append(r.client.readMailSetting(ctx, "template", state.OnBehalfOf.ValueString(), &got)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.fromAPI(got)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update applies the planned setting.
+func (r *MailSettingsTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan mailSettingsTemplateModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.write(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete disables the setting.
+func (r *MailSettingsTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	resp.Diagnostics.Append(r.client.requireScopes(ctx, "disable legacy template mail setting", "mail_settings.template.update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state mailSettingsTemplateModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var got mailSettingsTemplateAPI
+	resp.Diagnostics.Append(r.client.writeMailSetting(ctx, "template", state.OnBehalfOf.ValueString(), map[string]any{"enabled": false}, &got)...)
+}
+
+// ImportState allows `terraform import sendgrid_mail_settings_template.example <account|subuser username>`.
+func (r *MailSettingsTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountScopedState(ctx, req, resp)
+}
+
+// write PATCHes the setting from m and refreshes m from the response.
+func (r *MailSettingsTemplateResource) write(ctx context.Context, m *mailSettingsTemplateModel, diags *diag.Diagnostics) {
+	if r.client == nil {
+		diags.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	diags.Append(r.client.requireScopes(ctx, "update legacy template mail setting", "mail_settings.template.update")...)
+	if diags.HasError() {
+		return
+	}
+	var got mailSettingsTemplateAPI
+	diags.Append(r.client.writeMailSetting(ctx, "template", m.OnBehalfOf.ValueString(), m.payload(), &got)...)
+	if diags.HasError() {
+		return
+	}
+	m.fromAPI(got)
+}
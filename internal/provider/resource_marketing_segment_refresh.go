@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sendgrid/sendgrid-go"
+)
+
+// NOTE: Action-style resource that manually refreshes a Marketing Campaigns segment.
+//
+// API Endpoints:
+//   - Create: POST /v3/marketing/segments/2.0/refresh/{segment_id}  body {"user_time_zone": "..."}
+//   - Read/Update/Delete: no API calls
+//
+// API Documentation:
+//   - Manually refresh a segment: https://www.twilio.com/docs/sendgrid/api-reference/segmenting-contacts-v2/manually-refresh-a-segment
+//
+// The API only queues the refresh and returns a job ID; it does not report
+// when the job finishes, so the resource does not wait for it.
+
+var _ resource.Resource = (*MarketingSegmentRefreshResource)(nil)
+var _ resource.ResourceWithConfigure = (*MarketingSegmentRefreshResource)(nil)
+
+func NewMarketingSegmentRefreshResource() resource.Resource {
+	return &MarketingSegmentRefreshResource{}
+}
+
+type MarketingSegmentRefreshResource struct{ client *Client }
+
+type marketingSegmentRefreshModel struct {
+	ID           types.String `tfsdk:"id"`
+	SegmentID    types.String `tfsdk:"segment_id"`
+	UserTimeZone types.String `tfsdk:"user_time_zone"`
+	Triggers     types.Map    `tfsdk:"triggers"`
+	JobID        types.String `tfsdk:"job_id"`
+	RefreshedAt  types.String `tfsdk:"refreshed_at"`
+}
+
+func (r *MarketingSegmentRefreshResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_marketing_segment_refresh"
+}
+
+func (r *MarketingSegmentRefreshResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pc, ok := req.ProviderData.(*Client)
+	if !ok || pc == nil {
+		resp.Diagnostics.AddError("Unexpected ProviderData",
+			"Expected *Client, got something else")
+		return
+	}
+	r.client = pc
+}
+
+func (r *MarketingSegmentRefreshResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	replace := []planmodifier.String{stringplanmodifier.RequiresReplace()}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Queue a manual refresh of a Marketing Campaigns segment via `POST /v3/marketing/segments/2.0/refresh/{segment_id}`, e.g. right before scheduling a single send instead of waiting for the background refresh. The refresh is requested on create; changing any argument (e.g. a value in `triggers`) requests it again. The apply does not wait for the refresh to finish. Destroying the resource makes no API call.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of this refresh request (`segment_id` and `refreshed_at`).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"segment_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the segment to refresh.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: replace,
+			},
+			"user_time_zone": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("UTC"),
+				MarkdownDescription: "IANA timezone used to evaluate date conditions of the segment, e.g. `America/Chicago`. Defaults to `UTC`.",
+				PlanModifiers:       replace,
+			},
+			"triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Arbitrary values that request the refresh again when changed, e.g. the `send_at` of the single send that uses the segment.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"job_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the refresh job queued by SendGrid.",
+			},
+			"refreshed_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "RFC 3339 timestamp of when the refresh was accepted by SendGrid.",
+			},
+		},
+	}
+}
+
+// Create requests the refresh.
+func (r *MarketingSegmentRefreshResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	resp.Diagnostics.Append(r.client.requireScopes(ctx, "refresh segment", "mc.segments.update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan marketingSegmentRefreshModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	segmentID := plan.SegmentID.ValueString()
+	jobID, diags := r.client.refreshSegment(ctx, segmentID, plan.UserTimeZone.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshedAt := time.Now().UTC().Format(time.RFC3339)
+	plan.JobID = types.StringValue(jobID)
+	plan.RefreshedAt = types.StringValue(refreshedAt)
+	plan.ID = types.StringValue(segmentID + "@" + refreshedAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the recorded state; there is nothing to refresh.
+func (r *MarketingSegmentRefreshResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state marketingSegmentRefreshModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called with a diff, since every argument forces replacement.
+func (r *MarketingSegmentRefreshResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan marketingSegmentRefreshModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from state.
+func (r *MarketingSegmentRefreshResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// refreshSegment queues a refresh of the segment and returns the job ID.
+// POST /v3/marketing/segments/2.0/refresh/{segment_id}
+func (c *Client) refreshSegment(ctx context.Context, segmentID, userTimeZone string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	p := "/v3/marketing/segments/2.0/refresh/" + url.PathEscape(segmentID)
+	b, _ := json.Marshal(map[string]string{"user_time_zone": userTimeZone})
+	reqSG := sendgrid.GetRequest(c.APIKey, p, c.BaseURL)
+	reqSG.Method = "POST"
+	reqSG.Body = b
+
+	tflog.Debug(ctx, "POST /v3/marketing/segments/2.0/refresh/{segment_id}", map[string]any{"segment_id": segmentID})
+
+	sgResp, err := sendgrid.API(reqSG)
+	c.invalidateGetCache()
+	if err != nil {
+		diags.AddError("SendGrid API error", err.Error())
+		return "", diags
+	}
+	diags.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+	if sgResp.StatusCode >= 300 {
+		diags.AddError(
+			fmt.Sprintf("Refresh segment failed: %s", apiErrorMessage(sgResp.Body)),
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+		return "", diags
+	}
+
+	var queued struct {
+		JobID string `json:"job_id"`
+	}
+	if err := json.Unmarshal([]byte(sgResp.Body), &queued); err != nil {
+		diags.AddError("Parse error (refresh segment)", fmt.Sprintf("unable to parse body: %v", err))
+		return "", diags
+	}
+	return queued.JobID, diags
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRefreshSegment(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v3/marketing/segments/2.0/refresh/seg-1" {
+			http.NotFound(w, r)
+			return
+		}
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body["user_time_zone"] != "America/Chicago" {
+			t.Errorf("user_time_zone = %q", body["user_time_zone"])
+		}
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"job_id":"job-1"}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, APIKey: "test-key"}
+	jobID, diags := c.refreshSegment(context.Background(), "seg-1", "America/Chicago")
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if jobID != "job-1" {
+		t.Fatalf("job_id = %q", jobID)
+	}
+
+	if _, diags := c.refreshSegment(context.Background(), "missing", "UTC"); !diags.HasError() {
+		t.Fatal("expected an error for an unknown segment")
+	}
+}
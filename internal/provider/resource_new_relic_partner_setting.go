@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NOTE: This resource manages the New Relic partner setting of the account or
+// a subuser.
+//
+// API Endpoints:
+//   - Create/Update: PATCH /v3/partner_settings/new_relic
+//   - Read:          GET   /v3/partner_settings/new_relic
+//   - Delete:        PATCH /v3/partner_settings/new_relic  {"enabled": false}
+//
+// API Documentation:
+//   - Update New Relic partner settings: https://www.twilio.com/docs/sendgrid/api-reference/settings-partner/updates-new-relic-partner-settings
+//
+// Like a mail setting, the partner setting exists once per account, so the id,
+// on_behalf_of and import handling of settings.go are reused.
+
+var _ resource.Resource = (*NewRelicPartnerSettingResource)(nil)
+var _ resource.ResourceWithConfigure = (*NewRelicPartnerSettingResource)(nil)
+var _ resource.ResourceWithImportState = (*NewRelicPartnerSettingResource)(nil)
+
+func NewNewRelicPartnerSettingResource() resource.Resource {
+	return &NewRelicPartnerSettingResource{}
+}
+
+type NewRelicPartnerSettingResource struct{ client *Client }
+
+type newRelicPartnerSettingModel struct {
+	ID                      types.String `tfsdk:"id"`
+	OnBehalfOf              types.String `tfsdk:"on_behalf_of"`
+	Enabled                 types.Bool   `tfsdk:"enabled"`
+	LicenseKey              types.String `tfsdk:"license_key"`
+	EnableSubuserStatistics types.Bool   `tfsdk:"enable_subuser_statistics"`
+}
+
+// newRelicPartnerSettingAPI is the body of GET and PATCH
+// /v3/partner_settings/new_relic.
+type newRelicPartnerSettingAPI struct {
+	Enabled                 bool   `json:"enabled"`
+	LicenseKey              string `json:"license_key,omitempty"`
+	EnableSubuserStatistics *bool  `json:"enable_subuser_statistics,omitempty"`
+}
+
+const (
+	newRelicPartnerSettingPath = "/v3/partner_settings/new_relic"
+	newRelicPartnerSettingName = "New Relic partner setting"
+)
+
+func (r *NewRelicPartnerSettingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_new_relic_partner_setting"
+}
+
+func (r *NewRelicPartnerSettingResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pc, ok := req.ProviderData.(*Client)
+	if !ok || pc == nil {
+		resp.Diagnostics.AddError("Unexpected ProviderData",
+			"Expected *Client, got something else")
+		return
+	}
+	r.client = pc
+}
+
+func (r *NewRelicPartnerSettingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the New Relic partner setting via `/v3/partner_settings/new_relic`: when enabled, SendGrid sends email statistics to the New Relic account of `license_key`. The setting always exists, so destroying the resource disables it.",
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Required:            true,
+				MarkdownDescription: "Whether statistics are sent to New Relic.",
+			},
+			"license_key": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "New Relic license key of the account that receives the statistics.",
+			},
+			"enable_subuser_statistics": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether statistics of subusers are sent as well. When omitted, the value stored by SendGrid is recorded.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+	for name, attr := range accountScopedAttributes() {
+		resp.Schema.Attributes[name] = attr
+	}
+}
+
+func (m newRelicPartnerSettingModel) payload() newRelicPartnerSettingAPI {
+	p := newRelicPartnerSettingAPI{Enabled: m.Enabled.ValueBool(), LicenseKey: m.LicenseKey.ValueString()}
+	if !m.EnableSubuserStatistics.IsNull() && !m.EnableSubuserStatistics.IsUnknown() {
+		v := m.EnableSubuserStatistics.ValueBool()
+		p.EnableSubuserStatistics = &v
+	}
+	return p
+}
+
+// fromAPI records v in m. A license key missing from the response keeps the
+// one in m, so a masked or omitted key does not show as drift.
+func (m *newRelicPartnerSettingModel) fromAPI(v newRelicPartnerSettingAPI) {
+	m.ID = types.StringValue(accountScopedID(m.OnBehalfOf.ValueString()))
+	m.Enabled = types.BoolValue(v.Enabled)
+	if v.LicenseKey != "" {
+		m.LicenseKey = types.StringValue(v.LicenseKey)
+	}
+	m.EnableSubuserStatistics = types.BoolValue(v.EnableSubuserStatistics != nil && *v.EnableSubuserStatistics)
+}
+
+// Create applies the planned setting.
+func (r *NewRelicPartnerSettingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan newRelicPartnerSettingModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.write(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read fetches the current setting.
+func (r *NewRelicPartnerSettingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	var state newRelicPartnerSettingModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var got newRelicPartnerSettingAPI
+	resp.Diagnostics.Append(r.client.settingRequest(ctx, newRelicPartnerSettingPath, newRelicPartnerSettingName, state.OnBehalfOf.ValueString(), nil, &got)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.fromAPI(got)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update applies the planned setting.
+func (r *NewRelicPartnerSettingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan newRelicPartnerSettingModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.write(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete disables the setting.
+func (r *NewRelicPartnerSettingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	resp.Diagnostics.Append(r.client.requireScopes(ctx, "disable New Relic partner setting", "partner_settings.new_relic.update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state newRelicPartnerSettingModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var got newRelicPartnerSettingAPI
+	resp.Diagnostics.Append(r.client.writeSetting(ctx, newRelicPartnerSettingPath, newRelicPartnerSettingName, state.OnBehalfOf.ValueString(), map[string]any{"enabled": false}, &got)...)
+}
+
+// ImportState allows `terraform import sendgrid_new_relic_partner_setting.example <account|subuser username>`.
+// license_key cannot be imported when SendGrid does not return it; set it in
+// configuration and apply once after importing.
+func (r *NewRelicPartnerSettingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountScopedState(ctx, req, resp)
+}
+
+// write PATCHes the setting from m and refreshes m from the response.
+func (r *NewRelicPartnerSettingResource) write(ctx context.Context, m *newRelicPartnerSettingModel, diags *diag.Diagnostics) {
+	if r.client == nil {
+		diags.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	diags.Append(r.client.requireScopes(ctx, "update New Relic partner setting", "partner_settings.new_relic.update")...)
+	if diags.HasError() {
+		return
+	}
+	var got newRelicPartnerSettingAPI
+	diags.Append(r.client.writeSetting(ctx, newRelicPartnerSettingPath, newRelicPartnerSettingName, m.OnBehalfOf.ValueString(), m.payload(), &got)...)
+	if diags.HasError() {
+		return
+	}
+	m.fromAPI(got)
+}
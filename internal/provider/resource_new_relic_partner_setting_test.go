@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNewRelicPartnerSetting_WriteAndRead(t *testing.T) {
+	var patched map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != newRelicPartnerSettingPath {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Method == http.MethodPatch {
+			_ = json.NewDecoder(r.Body).Decode(&patched)
+		}
+		// SendGrid does not echo the license key back.
+		_, _ = w.Write([]byte(`{"enabled":true,"enable_subuser_statistics":true}`))
+	}))
+	defer srv.Close()
+
+	r := &NewRelicPartnerSettingResource{client: &Client{BaseURL: srv.URL, APIKey: "test-key"}}
+	m := newRelicPartnerSettingModel{
+		OnBehalfOf:              types.StringNull(),
+		Enabled:                 types.BoolValue(true),
+		LicenseKey:              types.StringValue("nr-key"),
+		EnableSubuserStatistics: types.BoolUnknown(),
+	}
+	var got newRelicPartnerSettingAPI
+	if diags := r.client.writeSetting(context.Background(), newRelicPartnerSettingPath, newRelicPartnerSettingName, "", m.payload(), &got); diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if patched["license_key"] != "nr-key" || patched["enabled"] != true {
+		t.Fatalf("unexpected PATCH body: %v", patched)
+	}
+	if _, ok := patched["enable_subuser_statistics"]; ok {
+		t.Fatalf("unknown enable_subuser_statistics was sent: %v", patched)
+	}
+
+	m.fromAPI(got)
+	if m.ID.ValueString() != parentAccountID || m.LicenseKey.ValueString() != "nr-key" || !m.EnableSubuserStatistics.ValueBool() {
+		t.Fatalf("unexpected model: %+v", m)
+	}
+}
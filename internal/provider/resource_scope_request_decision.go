@@ -0,0 +1,258 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sendgrid/sendgrid-go"
+)
+
+// NOTE: Action-style resource that approves or denies a pending teammate
+// access request.
+//
+// API Endpoints:
+//   - Lookup:  GET    /v3/scopes/requests?limit&offset
+//   - Approve: PATCH  /v3/scopes/requests/{request_id}/approve
+//   - Deny:    DELETE /v3/scopes/requests/{request_id}
+//   - Read/Update/Delete of the resource itself: no API calls
+//
+// API Documentation:
+//   - Retrieve access requests: https://www.twilio.com/docs/sendgrid/api-reference/teammates/retrieve-access-requests
+//   - Approve access request:   https://www.twilio.com/docs/sendgrid/api-reference/teammates/approve-access-request
+//   - Deny access request:      https://www.twilio.com/docs/sendgrid/api-reference/teammates/deny-access-request
+//
+// A request disappears from the list once decided, so the decision is made
+// once on create and the recorded state is never refreshed. Destroying the
+// resource does not revoke an approval.
+
+// scopeRequestsPageSize is the limit used when listing access requests.
+var scopeRequestsPageSize = 50
+
+var _ resource.Resource = (*ScopeRequestDecisionResource)(nil)
+var _ resource.ResourceWithConfigure = (*ScopeRequestDecisionResource)(nil)
+
+func NewScopeRequestDecisionResource() resource.Resource { return &ScopeRequestDecisionResource{} }
+
+type ScopeRequestDecisionResource struct{ client *Client }
+
+type scopeRequestDecisionModel struct {
+	ID             types.String `tfsdk:"id"`
+	RequestID      types.String `tfsdk:"request_id"`
+	Decision       types.String `tfsdk:"decision"`
+	Username       types.String `tfsdk:"username"`
+	Email          types.String `tfsdk:"email"`
+	ScopeGroupName types.String `tfsdk:"scope_group_name"`
+	DecidedAt      types.String `tfsdk:"decided_at"`
+}
+
+// scopeRequest is one element of GET /v3/scopes/requests.
+type scopeRequest struct {
+	ID             int64  `json:"id"`
+	ScopeGroupName string `json:"scope_group_name"`
+	Username       string `json:"username"`
+	Email          string `json:"email"`
+	FirstName      string `json:"first_name"`
+	LastName       string `json:"last_name"`
+}
+
+func (r *ScopeRequestDecisionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scope_request_decision"
+}
+
+func (r *ScopeRequestDecisionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pc, ok := req.ProviderData.(*Client)
+	if !ok || pc == nil {
+		resp.Diagnostics.AddError("Unexpected ProviderData",
+			"Expected *Client, got something else")
+		return
+	}
+	r.client = pc
+}
+
+func (r *ScopeRequestDecisionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	replace := []planmodifier.String{stringplanmodifier.RequiresReplace()}
+	computed := []planmodifier.String{stringplanmodifier.UseStateForUnknown()}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Approve or deny a pending teammate access request (`/v3/scopes/requests`), so access escalations are reviewed like any other change. The decision is made on create and fails when the request is not pending. Destroying the resource makes no API call and does not revoke an approval.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Same as `request_id`.",
+				PlanModifiers:       computed,
+			},
+			"request_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the pending access request, as listed by GET /v3/scopes/requests.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: replace,
+			},
+			"decision": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "`approve` or `deny`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("approve", "deny"),
+				},
+				PlanModifiers: replace,
+			},
+			"username": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Username of the teammate who made the request.",
+				PlanModifiers:       computed,
+			},
+			"email": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Email of the teammate who made the request.",
+				PlanModifiers:       computed,
+			},
+			"scope_group_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Name of the scope group the teammate asked for.",
+				PlanModifiers:       computed,
+			},
+			"decided_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "RFC 3339 timestamp of when the decision was applied.",
+				PlanModifiers:       computed,
+			},
+		},
+	}
+}
+
+// Create looks up the pending request and applies the decision.
+func (r *ScopeRequestDecisionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	resp.Diagnostics.Append(r.client.requireScopes(ctx, "decide teammate access request", "teammates.update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan scopeRequestDecisionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requestID := plan.RequestID.ValueString()
+	pending, found, diags := r.client.findScopeRequest(ctx, requestID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Access request not pending",
+			fmt.Sprintf("No pending access request with ID %q (GET /v3/scopes/requests). It may already have been approved, denied, or withdrawn.", requestID))
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.decideScopeRequest(ctx, requestID, plan.Decision.ValueString() == "approve")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(requestID)
+	plan.Username = stringOrNull(pending.Username)
+	plan.Email = stringOrNull(pending.Email)
+	plan.ScopeGroupName = stringOrNull(pending.ScopeGroupName)
+	plan.DecidedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the recorded state; a decided request is no longer listed.
+func (r *ScopeRequestDecisionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state scopeRequestDecisionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called with a diff, since every argument forces replacement.
+func (r *ScopeRequestDecisionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan scopeRequestDecisionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from state.
+func (r *ScopeRequestDecisionResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// findScopeRequest pages through the pending access requests looking for
+// requestID.
+func (c *Client) findScopeRequest(ctx context.Context, requestID string) (scopeRequest, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	for offset := 0; ; offset += scopeRequestsPageSize {
+		var page []scopeRequest
+		query := map[string]string{
+			"limit":  strconv.Itoa(scopeRequestsPageSize),
+			"offset": strconv.Itoa(offset),
+		}
+		if !c.getJSON(ctx, "/v3/scopes/requests", query, &page, &diags) {
+			return scopeRequest{}, false, diags
+		}
+		for _, sr := range page {
+			if strconv.FormatInt(sr.ID, 10) == requestID {
+				return sr, true, diags
+			}
+		}
+		if len(page) < scopeRequestsPageSize {
+			return scopeRequest{}, false, diags
+		}
+	}
+}
+
+// decideScopeRequest approves or denies the access request.
+// PATCH /v3/scopes/requests/{request_id}/approve, DELETE /v3/scopes/requests/{request_id}
+func (c *Client) decideScopeRequest(ctx context.Context, requestID string, approve bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	p, action := "/v3/scopes/requests/"+requestID, "Deny"
+	if approve {
+		p, action = p+"/approve", "Approve"
+	}
+	reqSG := sendgrid.GetRequest(c.APIKey, p, c.BaseURL)
+	reqSG.Method = "DELETE"
+	if approve {
+		reqSG.Method = "PATCH"
+	}
+
+	tflog.Debug(ctx, string(reqSG.Method)+" "+p, map[string]any{"request_id": requestID})
+
+	sgResp, err := sendgrid.API(reqSG)
+	c.invalidateGetCache()
+	if err != nil {
+		diags.AddError("SendGrid API error", err.Error())
+		return diags
+	}
+	diags.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+	if sgResp.StatusCode >= 300 {
+		diags.AddError(
+			fmt.Sprintf("%s access request %s failed: %s", action, requestID, apiErrorMessage(sgResp.Body)),
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+	}
+	return diags
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestScopeRequestDecision_FindAndDecide(t *testing.T) {
+	old := scopeRequestsPageSize
+	scopeRequestsPageSize = 1
+	defer func() { scopeRequestsPageSize = old }()
+
+	requests := []scopeRequest{
+		{ID: 1, Username: "bob", ScopeGroupName: "Mail Send"},
+		{ID: 2, Username: "carol", ScopeGroupName: "Stats"},
+	}
+	var decided []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v3/scopes/requests":
+			offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+			end := min(offset+1, len(requests))
+			_ = json.NewEncoder(w).Encode(requests[min(offset, end):end])
+		case r.Method == http.MethodPatch && r.URL.Path == "/v3/scopes/requests/2/approve":
+			decided = append(decided, "approve 2")
+			_, _ = w.Write([]byte(`{"scope_group_name":"Stats"}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/v3/scopes/requests/1":
+			decided = append(decided, "deny 1")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := &Client{BaseURL: srv.URL, APIKey: "test-key"}
+
+	got, found, diags := c.findScopeRequest(ctx, "2")
+	if diags.HasError() || !found || got.Username != "carol" {
+		t.Fatalf("findScopeRequest(2) = %+v, %v, %v", got, found, diags)
+	}
+	if _, found, diags := c.findScopeRequest(ctx, "3"); diags.HasError() || found {
+		t.Fatalf("findScopeRequest(3) found=%v diags=%v", found, diags)
+	}
+
+	if diags := c.decideScopeRequest(ctx, "2", true); diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if diags := c.decideScopeRequest(ctx, "1", false); diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if len(decided) != 2 || decided[0] != "approve 2" || decided[1] != "deny 1" {
+		t.Fatalf("decided = %v", decided)
+	}
+}
@@ -5,7 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -14,8 +18,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/sendgrid/sendgrid-go"
 )
@@ -40,6 +46,8 @@ import (
 
 var _ resource.Resource = (*SSOTeammateResource)(nil)
 var _ resource.ResourceWithConfigure = (*SSOTeammateResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*SSOTeammateResource)(nil)
+var _ resource.ResourceWithConfigValidators = (*SSOTeammateResource)(nil)
 
 func NewSSOTeammateResource() resource.Resource { return &SSOTeammateResource{} }
 
@@ -51,16 +59,36 @@ type ssoTeammateModel struct {
 	FirstName types.String `tfsdk:"first_name"`
 	LastName  types.String `tfsdk:"last_name"`
 	IsAdmin   types.Bool   `tfsdk:"is_admin"`
+	Persona   types.String `tfsdk:"persona"`
 	Scopes    types.Set    `tfsdk:"scopes"`
 
-	HasRestricted types.Bool   `tfsdk:"has_restricted_subuser_access"`
-	SubuserAccess types.Set    `tfsdk:"subuser_access"`
-	Status        types.String `tfsdk:"status"`
+	HasRestricted         types.Bool   `tfsdk:"has_restricted_subuser_access"`
+	SubuserAccess         types.Set    `tfsdk:"subuser_access"`
+	AllSubusers           types.Object `tfsdk:"all_subusers"`
+	ExternalSubuserAccess types.Bool   `tfsdk:"external_subuser_access"`
+	Status                types.String `tfsdk:"status"`
+
+	SubuserAccessDetails types.Map `tfsdk:"subuser_access_details"`
+
+	IgnoreRemoteScopeAdditions types.Bool  `tfsdk:"ignore_remote_scope_additions"`
+	SubuserAccessPageSize      types.Int64 `tfsdk:"subuser_access_page_size"`
+	SubuserAccessFastRefresh   types.Bool  `tfsdk:"subuser_access_fast_refresh"`
+	ValidateSubuserIDs         types.Bool  `tfsdk:"validate_subuser_ids"`
+
+	WaitForStatus types.String `tfsdk:"wait_for_status"`
+	WaitTimeout   types.String `tfsdk:"wait_timeout"`
+
+	DisableOnDestroy types.Bool `tfsdk:"disable_on_destroy"`
+	AdoptExisting    types.Bool `tfsdk:"adopt_existing"`
+
+	SkipReadAfterApply types.Bool `tfsdk:"skip_read_after_apply"`
 }
 
 type subuserAccessObject struct {
 	ID             types.String `tfsdk:"id"`
+	Username       types.String `tfsdk:"username"`
 	PermissionType types.String `tfsdk:"permission_type"`
+	Role           types.String `tfsdk:"role"`
 	Scopes         types.Set    `tfsdk:"scopes"`
 }
 
@@ -91,10 +119,13 @@ func (r *SSOTeammateResource) Schema(_ context.Context, _ resource.SchemaRequest
 			},
 			"email": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "Teammate email (also used as username for SSO).",
+				MarkdownDescription: "Teammate email (also used as username for SSO). SendGrid cannot rename a teammate, so changing this forces replacement.",
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(3),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"first_name": schema.StringAttribute{
 				Optional:            true,
@@ -107,13 +138,24 @@ func (r *SSOTeammateResource) Schema(_ context.Context, _ resource.SchemaRequest
 			"is_admin": schema.BoolAttribute{
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "Set true to grant full admin access to the main account. When true, `scopes` is ignored.",
+				MarkdownDescription: "Set true to grant full admin access to the main account. When true, `scopes` is ignored. Cannot be combined with `persona`, `has_restricted_subuser_access = true`, or `subuser_access`.",
+			},
+			"persona": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Predefined main-account permission set: `accountant`, `developer`, `marketer`, or `observer`. Alternative to `scopes`; cannot be combined with `is_admin = true` or `has_restricted_subuser_access = true`. Not returned by the API, so drift on this value cannot be detected.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("accountant", "developer", "marketer", "observer"),
+					stringvalidator.ConflictsWith(path.MatchRoot("scopes")),
+				},
 			},
 			"scopes": schema.SetAttribute{
 				ElementType:         types.StringType,
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "Main account permission scopes. Only effective when `is_admin = false`. Cannot be combined with `has_restricted_subuser_access = true`.",
+				MarkdownDescription: "Main account permission scopes for non-admin teammates without restricted subuser access. Only effective when `is_admin = false`. Mutually exclusive with `persona`, `subuser_access`, and `has_restricted_subuser_access = true`.",
+				Validators: []validator.Set{
+					scopeSetValidator{},
+				},
 			},
 			"has_restricted_subuser_access": schema.BoolAttribute{
 				Required:            true,
@@ -123,18 +165,93 @@ func (r *SSOTeammateResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Computed:            true,
 				MarkdownDescription: "Current teammate status returned by GET /v3/teammates/{username} (e.g., active, pending).",
 			},
+			"subuser_access_details": schema.MapNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Details of each subuser the teammate has access to, keyed by subuser ID, as returned by GET /v3/teammates/{username}/subuser_access. Empty for admins.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"username": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Subuser username.",
+						},
+						"email": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Subuser email.",
+						},
+						"disabled": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the subuser is disabled.",
+						},
+					},
+				},
+			},
+			"ignore_remote_scope_additions": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Set true to tolerate scopes granted outside Terraform (e.g., manually during an incident). Scopes present remotely but not in state are ignored on refresh for both `scopes` and `subuser_access.scopes`; scopes removed remotely are still reconciled on the next apply.",
+			},
+			"subuser_access_page_size": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Number of entries requested per page when reading `subuser_access` (1-%d). Defaults to %d. Raise it to reduce round trips for teammates with access to thousands of subusers.", maxSubuserAccessPageSize, defaultSubuserAccessPageSize),
+				Validators: []validator.Int64{
+					int64validator.Between(1, maxSubuserAccessPageSize),
+				},
+			},
+			"subuser_access_fast_refresh": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Set true to speed up refresh for teammates with access to many subusers: a fingerprint of the first `subuser_access` page is kept in private state, and when that page is unchanged the remaining pages are not read and `subuser_access` is kept from state. Changes made outside Terraform that only touch later pages are not detected until the first page changes too or the resource is applied again.",
+			},
+			"external_subuser_access": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Set true when the teammate's subuser access is granted by `sendgrid_sso_teammate_subuser_access` resources. `subuser_access` is then not refreshed from SendGrid, so those grants do not show as drift here. Requires `has_restricted_subuser_access = true`; cannot be combined with `subuser_access` or `all_subusers`.",
+			},
+			"validate_subuser_ids": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Set true to verify at plan time that every `subuser_access.id` is an existing subuser (via GET /v3/subusers), so a typo fails the plan instead of a partially applied teammate.",
+			},
+			"wait_for_status": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "When set to `active`, create polls GET /v3/teammates/{username} until the teammate is no longer `pending`, so dependent resources that need an active teammate do not race. Fails when `wait_timeout` elapses first.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("active"),
+				},
+			},
+			"wait_timeout": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Maximum time to wait for `wait_for_status`, as a Go duration (e.g., `5m`). Defaults to `%s`.", defaultTeammateWaitTimeout),
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"disable_on_destroy": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Set true to keep the teammate on destroy and only revoke its access (`is_admin = false`, no scopes, `has_restricted_subuser_access = false`) instead of deleting it.",
+			},
+			"skip_read_after_apply": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Set true to skip reading the teammate and its `subuser_access` back after create and update, roughly halving the API calls of bulk applies on accounts with strict rate limits. State is then taken from the configuration: `status` is recorded as `pending` after create (`active` with `wait_for_status`) and `subuser_access_details` is null after subuser access changes, until the next refresh. Values SendGrid normalizes differently from the configuration show up as a diff on the next plan.",
+			},
+			"adopt_existing": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Set true to take over a teammate that already exists instead of failing on create, e.g. when converting regular teammates to SSO. An existing teammate (SSO or not) is looked up by `email` in GET /v3/teammates and updated to this configuration with PATCH /v3/sso/teammates/{username}, using its own username even when that is not its email; a pending invitation to `email` is cancelled and the SSO teammate is created in its place. Only affects create.",
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"subuser_access": schema.SetNestedBlock{
-				MarkdownDescription: "Per‑Subuser access when `has_restricted_subuser_access = true`. For `permission_type = restricted`, `scopes` must list allowed scopes.",
+				MarkdownDescription: "Per‑Subuser access when `has_restricted_subuser_access = true`. For `permission_type = restricted`, `scopes` must list allowed scopes. The whole list is sent in a single update, because SendGrid replaces a teammate's subuser access on every update.",
 				PlanModifiers: []planmodifier.Set{
 					setplanmodifier.UseStateForUnknown(),
 				},
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"id": schema.StringAttribute{
-							Required:            true,
-							MarkdownDescription: "Subuser ID.",
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "Subuser ID. Set either `id` or `username`; the other is resolved from GET /v3/subusers.",
+						},
+						"username": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "Subuser username, as an alternative to `id`.",
 						},
 						"permission_type": schema.StringAttribute{
 							Required:            true,
@@ -143,10 +260,22 @@ func (r *SSOTeammateResource) Schema(_ context.Context, _ resource.SchemaRequest
 								stringvalidator.OneOf("restricted", "admin"),
 							},
 						},
+						"role": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: fmt.Sprintf("Scope preset expanded into `scopes` at plan time (one of %s). Only valid with `permission_type = restricted`; conflicts with `scopes`.", "`"+strings.Join(subuserRoleNames(), "`, `")+"`"),
+							Validators: []validator.String{
+								stringvalidator.OneOf(subuserRoleNames()...),
+								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("scopes")),
+							},
+						},
 						"scopes": schema.SetAttribute{
 							ElementType:         types.StringType,
 							Optional:            true,
-							MarkdownDescription: "List of allowed scopes when `permission_type = restricted`. Ignored for `admin`.",
+							Computed:            true,
+							MarkdownDescription: "List of allowed scopes when `permission_type = restricted`. Ignored for `admin`. Computed from `role` when a role is set.",
+							Validators: []validator.Set{
+								scopeSetValidator{},
+							},
 							PlanModifiers: []planmodifier.Set{
 								setplanmodifier.UseStateForUnknown(),
 							},
@@ -154,17 +283,51 @@ func (r *SSOTeammateResource) Schema(_ context.Context, _ resource.SchemaRequest
 					},
 				},
 			},
+			"all_subusers": schema.SingleNestedBlock{
+				MarkdownDescription: "Grant the same access to every subuser instead of listing `subuser_access` blocks. The provider enumerates GET /v3/subusers at plan time, so subusers created later are picked up on the next plan. Requires `has_restricted_subuser_access = true`; cannot be combined with `subuser_access`, `scopes`, `persona`, or `is_admin = true`.",
+				Attributes: map[string]schema.Attribute{
+					"permission_type": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "`restricted` or `admin`. Required when the block is set.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("restricted", "admin"),
+						},
+					},
+					"scopes": schema.SetAttribute{
+						ElementType:         types.StringType,
+						Optional:            true,
+						MarkdownDescription: "Scopes granted on every subuser when `permission_type = restricted`.",
+						Validators: []validator.Set{
+							scopeSetValidator{},
+						},
+					},
+					"subuser_ids": schema.SetAttribute{
+						ElementType:         types.StringType,
+						Computed:            true,
+						MarkdownDescription: "Subuser IDs the access is applied to, resolved from GET /v3/subusers at plan time and refreshed from the teammate's actual subuser access.",
+					},
+				},
+			},
 		},
 	}
 }
 
+// Page sizes for GET /v3/teammates/{username}/subuser_access. The default
+// matches what the provider has always requested; the maximum is the largest
+// `limit` the API accepts.
+const (
+	defaultSubuserAccessPageSize = 100
+	maxSubuserAccessPageSize     = 500
+)
+
 // ---------- API payloads ----------
 
 type ssoCreatePayload struct {
-	Email     string `json:"email"`
-	FirstName string `json:"first_name,omitempty"`
-	LastName  string `json:"last_name,omitempty"`
-	IsAdmin   bool   `json:"is_admin"`
+	Email     string   `json:"email"`
+	FirstName string   `json:"first_name,omitempty"`
+	LastName  string   `json:"last_name,omitempty"`
+	IsAdmin   bool     `json:"is_admin"`
+	Persona   string   `json:"persona,omitempty"`
 	Scopes    []string `json:"scopes,omitempty"`
 
 	HasRestricted bool                 `json:"has_restricted_subuser_access"`
@@ -172,9 +335,10 @@ type ssoCreatePayload struct {
 }
 
 type ssoPatchPayload struct {
-	FirstName *string `json:"first_name,omitempty"`
-	LastName  *string `json:"last_name,omitempty"`
-	IsAdmin   *bool   `json:"is_admin,omitempty"`
+	FirstName *string  `json:"first_name,omitempty"`
+	LastName  *string  `json:"last_name,omitempty"`
+	IsAdmin   *bool    `json:"is_admin,omitempty"`
+	Persona   *string  `json:"persona,omitempty"`
 	Scopes    []string `json:"scopes,omitempty"`
 
 	HasRestricted *bool                `json:"has_restricted_subuser_access,omitempty"`
@@ -185,6 +349,14 @@ type subuserAccessEntry struct {
 	ID             int64    `json:"id"`
 	PermissionType string   `json:"permission_type"`
 	Scopes         []string `json:"scopes,omitempty"`
+
+	// Role is not part of the API; it is carried over from prior state.
+	Role string `json:"-"`
+
+	// Read-only details returned by GET .../subuser_access.
+	Username string `json:"-"`
+	Email    string `json:"-"`
+	Disabled bool   `json:"-"`
 }
 
 type teammateGetResponse struct {
@@ -197,25 +369,6 @@ type teammateGetResponse struct {
 	Scopes    []string `json:"scopes"`
 }
 
-type teammateSubuserAccessResponse struct {
-	HasRestrictedSubuserAccess bool `json:"has_restricted_subuser_access"`
-	SubuserAccess              []struct {
-		ID             int64    `json:"id"`
-		Username       string   `json:"username"`
-		Email          string   `json:"email"`
-		Disabled       bool     `json:"disabled"`
-		PermissionType string   `json:"permission_type"`
-		Scopes         []string `json:"scopes"`
-	} `json:"subuser_access"`
-	Metadata struct {
-		NextParams struct {
-			Limit          int64  `json:"limit"`
-			AfterSubuserID int64  `json:"after_subuser_id"`
-			Username       string `json:"username"`
-		} `json:"next_params"`
-	} `json:"_metadata"`
-}
-
 // ---------- CRUD ----------
 
 // Create creates an SSO Teammate.
@@ -226,6 +379,10 @@ func (r *SSOTeammateResource) Create(ctx context.Context, req resource.CreateReq
 		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
 		return
 	}
+	resp.Diagnostics.Append(r.client.requireScopes(ctx, "create SSO teammate", "teammates.create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	var plan ssoTeammateModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -238,6 +395,7 @@ func (r *SSOTeammateResource) Create(ctx context.Context, req resource.CreateReq
 		FirstName:     plan.FirstName.ValueString(),
 		LastName:      plan.LastName.ValueString(),
 		IsAdmin:       plan.IsAdmin.ValueBool(),
+		Persona:       plan.Persona.ValueString(),
 		HasRestricted: plan.HasRestricted.ValueBool(),
 	}
 
@@ -280,43 +438,87 @@ func (r *SSOTeammateResource) Create(ctx context.Context, req resource.CreateReq
 			payload.SubuserAccess = append(payload.SubuserAccess, entry)
 		}
 	}
-
-	b, _ := json.Marshal(payload)
-	reqSG := sendgrid.GetRequest(r.client.APIKey, "/v3/sso/teammates", r.client.BaseURL)
-	reqSG.Method = "POST"
-	reqSG.Body = b
-
-	sgResp, err := sendgrid.API(reqSG)
-	if err != nil {
-		resp.Diagnostics.AddError("SendGrid API error", err.Error())
-		return
+	if all, ok := plan.allSubusers(ctx, &resp.Diagnostics); ok {
+		payload.SubuserAccess = allSubusersEntries(ctx, all, &resp.Diagnostics)
 	}
-	if sgResp.StatusCode >= 300 {
-		resp.Diagnostics.AddError("Create SSO Teammate failed",
-			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// After create, read back teammate + subuser_access to ensure state is fully known
 	username := plan.Email.ValueString()
+	adopted := false
+	if plan.AdoptExisting.ValueBool() {
+		adoptedUsername, ok, diags := r.adoptExistingTeammate(ctx, payload)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		adopted = ok
+		if adopted && adoptedUsername != username {
+			username = adoptedUsername
+			resp.Diagnostics.Append(resp.Private.SetKey(ctx, adoptedUsernameKey, []byte(username))...)
+		}
+	}
 
-	tflog.Debug(ctx, "Post-create GET /v3/teammates/{username}", map[string]any{"username": username})
-	reqGet := sendgrid.GetRequest(r.client.APIKey, "/v3/teammates/"+username, r.client.BaseURL)
-	reqGet.Method = "GET"
-	getResp, err := sendgrid.API(reqGet)
-	if err != nil {
-		resp.Diagnostics.AddError("SendGrid API error (post-create read)", err.Error())
+	if !adopted {
+		b, _ := json.Marshal(payload)
+		reqSG := sendgrid.GetRequest(r.client.APIKey, "/v3/sso/teammates", r.client.BaseURL)
+		reqSG.Method = "POST"
+		reqSG.Body = b
+
+		sgResp, err := sendgrid.API(reqSG)
+		r.client.invalidateGetCache()
+		if err != nil {
+			resp.Diagnostics.AddError("SendGrid API error", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+		if sgResp.StatusCode >= 300 {
+			resp.Diagnostics.AddError("Create SSO Teammate failed",
+				fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+			return
+		}
+	}
+
+	if !plan.WaitForStatus.IsNull() && !plan.WaitForStatus.IsUnknown() {
+		// wait_timeout is validated at plan time, so a parse error cannot
+		// happen here.
+		timeout := defaultTeammateWaitTimeout
+		if !plan.WaitTimeout.IsNull() && !plan.WaitTimeout.IsUnknown() {
+			timeout, _ = time.ParseDuration(plan.WaitTimeout.ValueString())
+		}
+		if diags := r.waitForTeammateActive(ctx, username, timeout); diags.HasError() {
+			// The teammate exists; record it so Terraform taints it instead
+			// of losing track of it.
+			stateWithoutRead(ctx, &plan, nil, false, true, &resp.Diagnostics)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	if plan.SkipReadAfterApply.ValueBool() {
+		tflog.Debug(ctx, "Skipping post-create read", map[string]any{"username": username})
+		stateWithoutRead(ctx, &plan, nil, !plan.WaitForStatus.IsNull(), true, &resp.Diagnostics)
+		resp.Diagnostics.Append(recordSubuserAccessFingerprint(ctx, resp.Private, plan, teammateSnapshot{})...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 		return
 	}
-	if getResp.StatusCode >= 300 {
-		resp.Diagnostics.AddError("Post-create read failed", fmt.Sprintf("status=%d body=%s", getResp.StatusCode, getResp.Body))
+
+	// After create, read back teammate + subuser_access to ensure state is fully known
+
+	tflog.Debug(ctx, "Post-create read", map[string]any{"username": username})
+	snap, diags := r.readTeammateSnapshotAfterWrite(ctx, username, plan.IsAdmin.ValueBool(), plan.subuserAccessPageSize())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	var got teammateGetResponse
-	if err := json.Unmarshal([]byte(getResp.Body), &got); err != nil {
-		resp.Diagnostics.AddError("Parse error (post-create teammate)", fmt.Sprintf("unable to parse body: %v", err))
+	if !snap.Found {
+		resp.Diagnostics.AddError("Post-create read failed",
+			fmt.Sprintf("teammate %q was not found immediately after creation", username))
 		return
 	}
+	got := snap.Teammate
 
 	// map to state model
 	if got.FirstName != "" {
@@ -333,54 +535,25 @@ func (r *SSOTeammateResource) Create(ctx context.Context, req resource.CreateReq
 	plan.IsAdmin = types.BoolValue(got.IsAdmin)
 
 	if got.IsAdmin {
-		// Admin implies all scopes/subuser_access; skip pagination API call
-		// and preserve plan values to avoid perpetual diffs.
+		// Admin implies all scopes/subuser_access; subuser_access is not used
+		// and plan values are preserved to avoid perpetual diffs.
 		plan.HasRestricted = types.BoolValue(false)
 		if plan.Scopes.IsUnknown() {
 			plan.Scopes = scopesSliceToSet(nil)
 		}
 	} else {
+		got.Scopes = dropImpliedScopes(ctx, got.Scopes, plan.Scopes)
+		if plan.IgnoreRemoteScopeAdditions.ValueBool() {
+			got.Scopes = dropRemoteScopeAdditions(ctx, got.Scopes, plan.Scopes)
+		}
 		plan.Scopes = scopesSliceToSet(got.Scopes)
 
-		// Fetch subuser access with pagination (only for non-admin)
-		var allEntries []subuserAccessEntry
-		var hasRestricted bool
-		var afterID int64 = 0
-		for {
-			tflog.Debug(ctx, "Post-create GET subuser_access", map[string]any{"username": username, "after_subuser_id": afterID})
-			reqSA := sendgrid.GetRequest(r.client.APIKey, "/v3/teammates/"+username+"/subuser_access", r.client.BaseURL)
-			reqSA.Method = "GET"
-			if reqSA.QueryParams == nil {
-				reqSA.QueryParams = make(map[string]string)
-			}
-			reqSA.QueryParams["limit"] = "100"
-			if afterID > 0 {
-				reqSA.QueryParams["after_subuser_id"] = strconv.FormatInt(afterID, 10)
-			}
-			saResp, err := sendgrid.API(reqSA)
-			if err != nil {
-				resp.Diagnostics.AddError("SendGrid API error (post-create subuser_access)", err.Error())
-				return
-			}
-			if saResp.StatusCode >= 300 {
-				resp.Diagnostics.AddError("Post-create subuser_access read failed", fmt.Sprintf("status=%d body=%s", saResp.StatusCode, saResp.Body))
-				return
-			}
-			var sa teammateSubuserAccessResponse
-			if err := json.Unmarshal([]byte(saResp.Body), &sa); err != nil {
-				resp.Diagnostics.AddError("Parse error (post-create subuser_access)", fmt.Sprintf("unable to parse body: %v", err))
-				return
-			}
-			hasRestricted = sa.HasRestrictedSubuserAccess
-			for _, e := range sa.SubuserAccess {
-				allEntries = append(allEntries, subuserAccessEntry{ID: e.ID, PermissionType: e.PermissionType, Scopes: e.Scopes})
-			}
-			if sa.Metadata.NextParams.AfterSubuserID == 0 {
-				break
-			}
-			afterID = sa.Metadata.NextParams.AfterSubuserID
+		allEntries := dropImpliedSubuserScopes(ctx, snap.SubuserAccess, plan.SubuserAccess)
+		allEntries = carrySubuserAccessRoles(ctx, allEntries, plan.SubuserAccess)
+		if plan.IgnoreRemoteScopeAdditions.ValueBool() {
+			allEntries = dropRemoteSubuserScopeAdditions(ctx, allEntries, plan.SubuserAccess)
 		}
-		plan.HasRestricted = types.BoolValue(hasRestricted)
+		plan.HasRestricted = types.BoolValue(snap.HasRestricted)
 		planHasSubuserAccess := !plan.SubuserAccess.IsNull() && !plan.SubuserAccess.IsUnknown() && len(plan.SubuserAccess.Elements()) > 0
 		if planHasSubuserAccess {
 			plan.SubuserAccess = subuserAccessEntriesToSet(ctx, allEntries, &resp.Diagnostics)
@@ -389,7 +562,9 @@ func (r *SSOTeammateResource) Create(ctx context.Context, req resource.CreateReq
 			}
 		}
 	}
+	plan.SubuserAccessDetails = subuserAccessDetailsToMap(ctx, snap.SubuserAccess, &resp.Diagnostics)
 	plan.ID = types.StringValue(plan.Email.ValueString())
+	resp.Diagnostics.Append(recordSubuserAccessFingerprint(ctx, resp.Private, plan, snap)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -413,33 +588,30 @@ func (r *SSOTeammateResource) Read(ctx context.Context, req resource.ReadRequest
 	if username == "" && !state.ID.IsNull() && !state.ID.IsUnknown() {
 		username = state.ID.ValueString()
 	}
+	username = teammateUsername(ctx, req.Private, username)
 	if username == "" {
 		resp.Diagnostics.AddError("Missing identifier", "Both email and id are empty; cannot read resource")
 		return
 	}
-	reqSG := sendgrid.GetRequest(r.client.APIKey, "/v3/teammates/"+username, r.client.BaseURL)
-	reqSG.Method = "GET"
-	sgResp, err := sendgrid.API(reqSG)
-	if err != nil {
-		resp.Diagnostics.AddError("SendGrid API error", err.Error())
+	var snap teammateSnapshot
+	var unchanged bool
+	var diags diag.Diagnostics
+	if state.SubuserAccessFastRefresh.ValueBool() && !state.IsAdmin.ValueBool() {
+		stored := loadSubuserAccessFingerprint(ctx, req.Private)
+		snap, unchanged, diags = r.readTeammateSnapshotIfChanged(ctx, username, state.subuserAccessPageSize(), stored)
+	} else {
+		snap, diags = r.readTeammateSnapshot(ctx, username, state.IsAdmin.ValueBool(), state.subuserAccessPageSize())
+	}
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	if sgResp.StatusCode == 404 {
+	if !snap.Found {
 		// Treat as removed from remote
 		resp.State.RemoveResource(ctx)
 		return
 	}
-	if sgResp.StatusCode >= 300 {
-		resp.Diagnostics.AddError("Read teammate failed",
-			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
-		return
-	}
-
-	var got teammateGetResponse
-	if err := json.Unmarshal([]byte(sgResp.Body), &got); err != nil {
-		resp.Diagnostics.AddError("Parse error", fmt.Sprintf("unable to parse body: %v", err))
-		return
-	}
+	got := snap.Teammate
 
 	// normalize identifiers from API response
 	state.Email = types.StringValue(got.Email)
@@ -459,57 +631,48 @@ func (r *SSOTeammateResource) Read(ctx context.Context, req resource.ReadRequest
 
 	if got.IsAdmin {
 		// Admin gets all scopes/subuser_access implicitly from API.
-		// Skip the subuser_access pagination call entirely and store empty values.
+		// subuser_access is not used for admins; store empty values.
 		state.Scopes = scopesSliceToSet(nil)
 		state.HasRestricted = types.BoolValue(false)
 		state.SubuserAccess = types.SetNull(subuserAccessObjectType())
 	} else {
+		got.Scopes = dropImpliedScopes(ctx, got.Scopes, state.Scopes)
+		if state.IgnoreRemoteScopeAdditions.ValueBool() {
+			got.Scopes = dropRemoteScopeAdditions(ctx, got.Scopes, state.Scopes)
+		}
 		state.Scopes = scopesSliceToSet(got.Scopes)
+	}
 
-		// Fetch subuser access with pagination (only for non-admin)
-		var allEntries []subuserAccessEntry
-		var hasRestricted bool
-		var afterID int64 = 0
-		for {
-			reqSA := sendgrid.GetRequest(r.client.APIKey, "/v3/teammates/"+username+"/subuser_access", r.client.BaseURL)
-			reqSA.Method = "GET"
-			if reqSA.QueryParams == nil {
-				reqSA.QueryParams = make(map[string]string)
-			}
-			reqSA.QueryParams["limit"] = "100"
-			if afterID > 0 {
-				reqSA.QueryParams["after_subuser_id"] = strconv.FormatInt(afterID, 10)
-			}
-			saResp, err := sendgrid.API(reqSA)
-			if err != nil {
-				resp.Diagnostics.AddError("SendGrid API error (subuser_access)", err.Error())
-				return
-			}
-			if saResp.StatusCode >= 300 {
-				resp.Diagnostics.AddError("Read subuser access failed", fmt.Sprintf("status=%d body=%s", saResp.StatusCode, saResp.Body))
-				return
-			}
-			var sa teammateSubuserAccessResponse
-			if err := json.Unmarshal([]byte(saResp.Body), &sa); err != nil {
-				resp.Diagnostics.AddError("Parse error (subuser_access)", fmt.Sprintf("unable to parse body: %v", err))
-				return
-			}
-			hasRestricted = sa.HasRestrictedSubuserAccess
-			for _, e := range sa.SubuserAccess {
-				allEntries = append(allEntries, subuserAccessEntry{ID: e.ID, PermissionType: e.PermissionType, Scopes: e.Scopes})
-			}
-			if sa.Metadata.NextParams.AfterSubuserID == 0 {
-				break
-			}
-			afterID = sa.Metadata.NextParams.AfterSubuserID
+	if unchanged {
+		// subuser_access, has_restricted_subuser_access and
+		// subuser_access_details are kept from state; the fingerprint in
+		// private state still applies.
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	if !got.IsAdmin {
+		allEntries := dropImpliedSubuserScopes(ctx, snap.SubuserAccess, state.SubuserAccess)
+		allEntries = carrySubuserAccessRoles(ctx, allEntries, state.SubuserAccess)
+		if state.IgnoreRemoteScopeAdditions.ValueBool() {
+			allEntries = dropRemoteSubuserScopeAdditions(ctx, allEntries, state.SubuserAccess)
+		}
+		state.HasRestricted = types.BoolValue(snap.HasRestricted)
+		if state.ExternalSubuserAccess.ValueBool() {
+			// Granted by sendgrid_sso_teammate_subuser_access resources.
+			state.SubuserAccess = types.SetNull(subuserAccessObjectType())
+		} else if state.AllSubusers.IsNull() {
+			state.SubuserAccess = subuserAccessEntriesToSet(ctx, allEntries, &resp.Diagnostics)
+		} else {
+			refreshAllSubusers(ctx, &state, allEntries, &resp.Diagnostics)
 		}
-		state.HasRestricted = types.BoolValue(hasRestricted)
-		state.SubuserAccess = subuserAccessEntriesToSet(ctx, allEntries, &resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 	}
 
+	state.SubuserAccessDetails = subuserAccessDetailsToMap(ctx, snap.SubuserAccess, &resp.Diagnostics)
+	resp.Diagnostics.Append(recordSubuserAccessFingerprint(ctx, resp.Private, state, snap)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -521,6 +684,10 @@ func (r *SSOTeammateResource) Update(ctx context.Context, req resource.UpdateReq
 		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
 		return
 	}
+	resp.Diagnostics.Append(r.client.requireScopes(ctx, "update SSO teammate", "teammates.update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	var plan ssoTeammateModel
 	var state ssoTeammateModel
@@ -530,7 +697,7 @@ func (r *SSOTeammateResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	username := state.Email.ValueString() // email を username として扱う
+	username := teammateUsername(ctx, req.Private, state.Email.ValueString())
 
 	patch := ssoPatchPayload{}
 	if !plan.FirstName.IsNull() && !plan.FirstName.IsUnknown() {
@@ -545,6 +712,10 @@ func (r *SSOTeammateResource) Update(ctx context.Context, req resource.UpdateReq
 		v := plan.IsAdmin.ValueBool()
 		patch.IsAdmin = &v
 	}
+	if !plan.Persona.IsNull() && !plan.Persona.IsUnknown() {
+		v := plan.Persona.ValueString()
+		patch.Persona = &v
+	}
 	if !plan.Scopes.IsNull() && !plan.Scopes.IsUnknown() {
 		var scopes []string
 		resp.Diagnostics.Append(plan.Scopes.ElementsAs(ctx, &scopes, false)...)
@@ -553,12 +724,20 @@ func (r *SSOTeammateResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 		patch.Scopes = scopes
 	}
-	if !plan.HasRestricted.IsNull() && !plan.HasRestricted.IsUnknown() {
+	// Only resend has_restricted_subuser_access/subuser_access when either of
+	// them changed, so unrelated edits (e.g., first_name) on teammates with
+	// hundreds of subusers do not push the whole list again.
+	subuserAccessChanged := !plan.SubuserAccess.Equal(state.SubuserAccess) || !plan.HasRestricted.Equal(state.HasRestricted) ||
+		!plan.AllSubusers.Equal(state.AllSubusers)
+	if !subuserAccessChanged {
+		tflog.Debug(ctx, "subuser_access unchanged; omitting from PATCH", map[string]any{"username": username})
+	}
+	if subuserAccessChanged && !plan.HasRestricted.IsNull() && !plan.HasRestricted.IsUnknown() {
 		v := plan.HasRestricted.ValueBool()
 		patch.HasRestricted = &v
 	}
 	// subuser_access
-	if !plan.SubuserAccess.IsNull() && !plan.SubuserAccess.IsUnknown() {
+	if subuserAccessChanged && !plan.SubuserAccess.IsNull() && !plan.SubuserAccess.IsUnknown() {
 		var objs []subuserAccessObject
 		resp.Diagnostics.Append(plan.SubuserAccess.ElementsAs(ctx, &objs, false)...)
 		if resp.Diagnostics.HasError() {
@@ -583,40 +762,39 @@ func (r *SSOTeammateResource) Update(ctx context.Context, req resource.UpdateReq
 			patch.SubuserAccess = append(patch.SubuserAccess, entry)
 		}
 	}
-
-	b, _ := json.Marshal(patch)
-	reqSG := sendgrid.GetRequest(r.client.APIKey, "/v3/sso/teammates/"+username, r.client.BaseURL)
-	reqSG.Method = "PATCH"
-	reqSG.Body = b
-	sgResp, err := sendgrid.API(reqSG)
-	if err != nil {
-		resp.Diagnostics.AddError("SendGrid API error", err.Error())
+	if all, ok := plan.allSubusers(ctx, &resp.Diagnostics); ok && subuserAccessChanged {
+		patch.SubuserAccess = allSubusersEntries(ctx, all, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	if sgResp.StatusCode >= 300 {
-		resp.Diagnostics.AddError("Update SSO Teammate failed",
-			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+
+	resp.Diagnostics.Append(r.patchTeammate(ctx, username, patch)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// ---- Post-update readback to ensure all Computed attrs are known ----
-	tflog.Debug(ctx, "Post-update GET /v3/teammates/{username}", map[string]any{"username": username})
-	reqGet := sendgrid.GetRequest(r.client.APIKey, "/v3/teammates/"+username, r.client.BaseURL)
-	reqGet.Method = "GET"
-	getResp, err := sendgrid.API(reqGet)
-	if err != nil {
-		resp.Diagnostics.AddError("SendGrid API error (post-update read)", err.Error())
+	if plan.SkipReadAfterApply.ValueBool() {
+		tflog.Debug(ctx, "Skipping post-update read", map[string]any{"username": username})
+		stateWithoutRead(ctx, &plan, &state, false, subuserAccessChanged, &resp.Diagnostics)
+		resp.Diagnostics.Append(recordSubuserAccessFingerprint(ctx, resp.Private, plan, teammateSnapshot{})...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 		return
 	}
-	if getResp.StatusCode >= 300 {
-		resp.Diagnostics.AddError("Post-update read failed", fmt.Sprintf("status=%d body=%s", getResp.StatusCode, getResp.Body))
+
+	// ---- Post-update readback to ensure all Computed attrs are known ----
+	tflog.Debug(ctx, "Post-update read", map[string]any{"username": username})
+	snap, diags := r.readTeammateSnapshotAfterWrite(ctx, username, plan.IsAdmin.ValueBool(), plan.subuserAccessPageSize())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	var got teammateGetResponse
-	if err := json.Unmarshal([]byte(getResp.Body), &got); err != nil {
-		resp.Diagnostics.AddError("Parse error (post-update teammate)", fmt.Sprintf("unable to parse body: %v", err))
+	if !snap.Found {
+		resp.Diagnostics.AddError("Post-update read failed",
+			fmt.Sprintf("teammate %q was not found after update", username))
 		return
 	}
+	got := snap.Teammate
 
 	if got.FirstName != "" {
 		plan.FirstName = types.StringValue(got.FirstName)
@@ -632,53 +810,24 @@ func (r *SSOTeammateResource) Update(ctx context.Context, req resource.UpdateReq
 	plan.IsAdmin = types.BoolValue(got.IsAdmin)
 
 	if got.IsAdmin {
-		// Admin implies all scopes/subuser_access; skip pagination API call
+		// Admin implies all scopes/subuser_access; subuser_access is not used
 		plan.HasRestricted = types.BoolValue(false)
 		if plan.Scopes.IsUnknown() {
 			plan.Scopes = scopesSliceToSet(nil)
 		}
 	} else {
+		got.Scopes = dropImpliedScopes(ctx, got.Scopes, plan.Scopes)
+		if plan.IgnoreRemoteScopeAdditions.ValueBool() {
+			got.Scopes = dropRemoteScopeAdditions(ctx, got.Scopes, plan.Scopes)
+		}
 		plan.Scopes = scopesSliceToSet(got.Scopes)
 
-		// Fetch subuser access with pagination (only for non-admin)
-		var allEntries []subuserAccessEntry
-		var hasRestricted bool
-		var afterID int64 = 0
-		for {
-			tflog.Debug(ctx, "Post-update GET subuser_access", map[string]any{"username": username, "after_subuser_id": afterID})
-			reqSA := sendgrid.GetRequest(r.client.APIKey, "/v3/teammates/"+username+"/subuser_access", r.client.BaseURL)
-			reqSA.Method = "GET"
-			if reqSA.QueryParams == nil {
-				reqSA.QueryParams = make(map[string]string)
-			}
-			reqSA.QueryParams["limit"] = "100"
-			if afterID > 0 {
-				reqSA.QueryParams["after_subuser_id"] = strconv.FormatInt(afterID, 10)
-			}
-			saResp, err := sendgrid.API(reqSA)
-			if err != nil {
-				resp.Diagnostics.AddError("SendGrid API error (post-update subuser_access)", err.Error())
-				return
-			}
-			if saResp.StatusCode >= 300 {
-				resp.Diagnostics.AddError("Post-update subuser_access read failed", fmt.Sprintf("status=%d body=%s", saResp.StatusCode, saResp.Body))
-				return
-			}
-			var sa teammateSubuserAccessResponse
-			if err := json.Unmarshal([]byte(saResp.Body), &sa); err != nil {
-				resp.Diagnostics.AddError("Parse error (post-update subuser_access)", fmt.Sprintf("unable to parse body: %v", err))
-				return
-			}
-			hasRestricted = sa.HasRestrictedSubuserAccess
-			for _, e := range sa.SubuserAccess {
-				allEntries = append(allEntries, subuserAccessEntry{ID: e.ID, PermissionType: e.PermissionType, Scopes: e.Scopes})
-			}
-			if sa.Metadata.NextParams.AfterSubuserID == 0 {
-				break
-			}
-			afterID = sa.Metadata.NextParams.AfterSubuserID
+		allEntries := dropImpliedSubuserScopes(ctx, snap.SubuserAccess, plan.SubuserAccess)
+		allEntries = carrySubuserAccessRoles(ctx, allEntries, plan.SubuserAccess)
+		if plan.IgnoreRemoteScopeAdditions.ValueBool() {
+			allEntries = dropRemoteSubuserScopeAdditions(ctx, allEntries, plan.SubuserAccess)
 		}
-		plan.HasRestricted = types.BoolValue(hasRestricted)
+		plan.HasRestricted = types.BoolValue(snap.HasRestricted)
 		planHasSubuserAccess := !plan.SubuserAccess.IsNull() && !plan.SubuserAccess.IsUnknown() && len(plan.SubuserAccess.Elements()) > 0
 		if planHasSubuserAccess {
 			plan.SubuserAccess = subuserAccessEntriesToSet(ctx, allEntries, &resp.Diagnostics)
@@ -687,10 +836,56 @@ func (r *SSOTeammateResource) Update(ctx context.Context, req resource.UpdateReq
 			}
 		}
 	}
+	plan.SubuserAccessDetails = subuserAccessDetailsToMap(ctx, snap.SubuserAccess, &resp.Diagnostics)
 	plan.ID = types.StringValue(plan.Email.ValueString())
+	resp.Diagnostics.Append(recordSubuserAccessFingerprint(ctx, resp.Private, plan, snap)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// patchTeammate sends patch to PATCH /v3/sso/teammates/{username} in a single
+// call. The endpoint replaces the teammate's whole subuser access list, so
+// subuser_access cannot be split across several requests.
+func (r *SSOTeammateResource) patchTeammate(ctx context.Context, username string, patch ssoPatchPayload) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Debug(ctx, "PATCH /v3/sso/teammates/{username}", map[string]any{"username": username, "subuser_access_entries": len(patch.SubuserAccess)})
+
+	b, _ := json.Marshal(patch)
+	reqSG := sendgrid.GetRequest(r.client.APIKey, "/v3/sso/teammates/"+username, r.client.BaseURL)
+	reqSG.Method = "PATCH"
+	reqSG.Body = b
+	sgResp, err := sendgrid.API(reqSG)
+	r.client.invalidateGetCache()
+	if err != nil {
+		diags.AddError("Update SSO Teammate failed", err.Error())
+		return diags
+	}
+	diags.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+	switch {
+	case payloadTooLarge(sgResp.StatusCode, sgResp.Body):
+		diags.AddError("subuser_access is too large for a single update",
+			fmt.Sprintf("SendGrid rejected the %d byte update carrying %d subuser_access entries (status=%d: %s). The whole subuser access list has to be sent in one request, because every update replaces it; reduce the number of subuser_access entries.",
+				len(b), len(patch.SubuserAccess), sgResp.StatusCode, apiErrorMessage(sgResp.Body)))
+	case sgResp.StatusCode >= 300:
+		diags.AddError("Update SSO Teammate failed",
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+	}
+	return diags
+}
+
+// payloadTooLarge reports whether a failed write was rejected for the size of
+// its body: a 413, or a 400 whose message says the body is too large.
+func payloadTooLarge(status int, body string) bool {
+	switch status {
+	case 413:
+		return true
+	case 400:
+		msg := strings.ToLower(apiErrorMessage(body))
+		return strings.Contains(msg, "too large") || strings.Contains(msg, "exceed")
+	}
+	return false
+}
+
 // Delete removes an SSO Teammate.
 // DELETE /v3/teammates/{username}
 // https://www.twilio.com/docs/sendgrid/api-reference/teammates/delete-teammate
@@ -705,14 +900,30 @@ func (r *SSOTeammateResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	username := state.Email.ValueString()
+	username := teammateUsername(ctx, req.Private, state.Email.ValueString())
+	if state.DisableOnDestroy.ValueBool() {
+		resp.Diagnostics.Append(r.client.requireScopes(ctx, "revoke SSO teammate access", "teammates.update")...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(r.revokeTeammateAccess(ctx, username)...)
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.requireScopes(ctx, "delete SSO teammate", "teammates.delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	reqSG := sendgrid.GetRequest(r.client.APIKey, "/v3/teammates/"+username, r.client.BaseURL)
 	reqSG.Method = "DELETE"
 	sgResp, err := sendgrid.API(reqSG)
+	r.client.invalidateGetCache()
 	if err != nil {
 		resp.Diagnostics.AddError("SendGrid API error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
 	if sgResp.StatusCode >= 300 && sgResp.StatusCode != 404 {
 		resp.Diagnostics.AddError("Delete teammate failed",
 			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
@@ -720,20 +931,405 @@ func (r *SSOTeammateResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 }
 
+// revokeTeammateAccess strips every permission from the teammate while keeping
+// the identity, for disable_on_destroy. A teammate that is already gone is not
+// an error.
+func (r *SSOTeammateResource) revokeTeammateAccess(ctx context.Context, username string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// ssoPatchPayload omits empty values, so the revocation is spelled out.
+	b, _ := json.Marshal(map[string]any{
+		"is_admin":                      false,
+		"scopes":                        []string{},
+		"has_restricted_subuser_access": false,
+	})
+	tflog.Debug(ctx, "Revoking teammate access instead of deleting", map[string]any{"username": username})
+	reqSG := sendgrid.GetRequest(r.client.APIKey, "/v3/sso/teammates/"+username, r.client.BaseURL)
+	reqSG.Method = "PATCH"
+	reqSG.Body = b
+	sgResp, err := sendgrid.API(reqSG)
+	r.client.invalidateGetCache()
+	if err != nil {
+		diags.AddError("SendGrid API error", err.Error())
+		return diags
+	}
+	diags.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+	if sgResp.StatusCode >= 300 && sgResp.StatusCode != 404 {
+		diags.AddError("Revoke teammate access failed",
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+	}
+	return diags
+}
+
 // ImportState allows `terraform import sendgrid_sso_teammate.example <email>`.
 func (r *SSOTeammateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// defaultTeammateWaitTimeout bounds wait_for_status when wait_timeout is unset.
+const defaultTeammateWaitTimeout = 10 * time.Minute
+
+// durationValidator requires a string attribute to hold a positive Go
+// duration.
+type durationValidator struct{}
+
+var _ validator.String = durationValidator{}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a positive Go duration such as 5m"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid duration",
+			fmt.Sprintf("%s must be a Go duration such as 5m or 1h30m: %v", req.Path, err))
+		return
+	}
+	if d <= 0 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid duration",
+			fmt.Sprintf("%s must be greater than zero, got %s", req.Path, req.ConfigValue.ValueString()))
+	}
+}
+
+// teammateStatusPollInterval is the delay between status polls while waiting
+// for a teammate to become active. A variable so tests can shorten it.
+var teammateStatusPollInterval = 5 * time.Second
+
+// waitForTeammateActive polls GET /v3/teammates/{username} until the teammate
+// leaves `pending`, or errors once timeout elapses. A 404 is treated as still
+// pending, since a just-created teammate may not be readable yet.
+func (r *SSOTeammateResource) waitForTeammateActive(ctx context.Context, username string, timeout time.Duration) diag.Diagnostics {
+	deadline := time.Now().Add(timeout)
+	for {
+		r.client.forgetGet("/v3/teammates/"+username, nil)
+		got, found, diags := r.readTeammate(ctx, username)
+		if diags.HasError() {
+			return diags
+		}
+		status := "pending"
+		if found && got.Status != "" {
+			status = got.Status
+		}
+		tflog.Debug(ctx, "Waiting for teammate to become active", map[string]any{"username": username, "status": status})
+		if status != "pending" {
+			return diags
+		}
+		if time.Now().Add(teammateStatusPollInterval).After(deadline) {
+			diags.AddError("Timed out waiting for teammate",
+				fmt.Sprintf("teammate %q was still pending after %s", username, timeout))
+			return diags
+		}
+		select {
+		case <-ctx.Done():
+			diags.AddError("Canceled waiting for teammate", ctx.Err().Error())
+			return diags
+		case <-time.After(teammateStatusPollInterval):
+		}
+	}
+}
+
+// subuserAccessPageSize returns the configured subuser_access page size, or
+// the default when unset.
+func (m ssoTeammateModel) subuserAccessPageSize() int64 {
+	if m.SubuserAccessPageSize.IsNull() || m.SubuserAccessPageSize.IsUnknown() {
+		return defaultSubuserAccessPageSize
+	}
+	return m.SubuserAccessPageSize.ValueInt64()
+}
+
+// ModifyPlan performs plan-time checks that would otherwise only fail at apply:
+//   - configured scopes (top-level and per subuser_access entry) are validated
+//     against the live scope catalog (GET /v3/scopes);
+//   - when validate_subuser_ids is true, every subuser_access.id must exist
+//     (GET /v3/subusers);
+//   - subuser_access.role is expanded into subuser_access.scopes, and entries
+//     keyed by username get their id (and vice versa);
+//   - all_subusers.subuser_ids is resolved to the current subuser list.
+func (r *SSOTeammateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	// Validate what the user wrote rather than the plan, which may carry
+	// API-normalized values from prior state.
+	var cfg ssoTeammateModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries := configuredSubuserAccess(ctx, cfg.SubuserAccess, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.validateConfiguredScopes(ctx, cfg, entries, &resp.Diagnostics)
+	if cfg.ValidateSubuserIDs.ValueBool() {
+		r.validateSubuserIDs(ctx, entries, &resp.Diagnostics)
+	}
+
+	// The plan follows the configuration exactly, except that roles are
+	// expanded into scopes and the missing one of id/username is resolved.
+	// Those attributes are only Computed so that this can be planned.
+	if expanded, ok := expandSubuserAccessRoles(ctx, cfg.SubuserAccess, &resp.Diagnostics); ok {
+		var prior types.Set
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("subuser_access"), &prior)...)
+		expanded = r.resolveSubuserAccessKeys(ctx, expanded, prior, &resp.Diagnostics)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("subuser_access"), expanded)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !cfg.AllSubusers.IsNull() {
+		var plan ssoTeammateModel
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		r.planAllSubuserIDs(ctx, &plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("all_subusers"), plan.AllSubusers)...)
+	}
+}
+
+// configuredSubuserAccessEntry pairs a subuser_access element with its set
+// value, which is needed to build attribute paths into the set.
+type configuredSubuserAccessEntry struct {
+	Value  attr.Value
+	Object subuserAccessObject
+}
+
+// configuredSubuserAccess decodes the known elements of a subuser_access set.
+func configuredSubuserAccess(ctx context.Context, set types.Set, diags *diag.Diagnostics) []configuredSubuserAccessEntry {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+	out := make([]configuredSubuserAccessEntry, 0, len(set.Elements()))
+	for _, elem := range set.Elements() {
+		obj, ok := elem.(types.Object)
+		if !ok || obj.IsUnknown() {
+			continue
+		}
+		var o subuserAccessObject
+		diags.Append(obj.As(ctx, &o, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil
+		}
+		out = append(out, configuredSubuserAccessEntry{Value: elem, Object: o})
+	}
+	return out
+}
+
+// validateConfiguredScopes checks every configured scope set (top-level,
+// subuser_access, and all_subusers) against the scope catalog.
+func (r *SSOTeammateResource) validateConfiguredScopes(ctx context.Context, cfg ssoTeammateModel, entries []configuredSubuserAccessEntry, diags *diag.Diagnostics) {
+	sets := []scopeSetAtPath{{Path: path.Root("scopes"), Scopes: cfg.Scopes}}
+	for _, e := range entries {
+		sets = append(sets, scopeSetAtPath{
+			Path:   path.Root("subuser_access").AtSetValue(e.Value).AtName("scopes"),
+			Scopes: e.Object.Scopes,
+		})
+	}
+	if all, ok := cfg.allSubusers(ctx, diags); ok {
+		sets = append(sets, scopeSetAtPath{Path: path.Root("all_subusers").AtName("scopes"), Scopes: all.Scopes})
+	}
+	r.client.checkScopeSets(ctx, sets, diags)
+}
+
+// validateSubuserIDs reports an attribute error for every subuser_access.id
+// that does not match an existing subuser, so a typo fails the plan instead of
+// leaving a partially created teammate mid-apply.
+func (r *SSOTeammateResource) validateSubuserIDs(ctx context.Context, entries []configuredSubuserAccessEntry, diags *diag.Diagnostics) {
+	if len(entries) == 0 {
+		return
+	}
+	subusers, listDiags := r.client.allSubusers(ctx)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return
+	}
+	known := make(map[string]struct{}, len(subusers))
+	for _, su := range subusers {
+		known[strconv.FormatInt(su.ID, 10)] = struct{}{}
+	}
+	for _, e := range entries {
+		if e.Object.ID.IsNull() || e.Object.ID.IsUnknown() {
+			continue
+		}
+		id := e.Object.ID.ValueString()
+		if _, ok := known[id]; !ok {
+			diags.AddAttributeError(path.Root("subuser_access").AtSetValue(e.Value).AtName("id"),
+				"Unknown subuser",
+				fmt.Sprintf("No subuser with ID %q exists in this account (GET /v3/subusers).", id))
+		}
+	}
+}
+
+// teammateSnapshot is the combined result of reading a teammate and, for
+// non-admins, every page of its subuser access.
+type teammateSnapshot struct {
+	Teammate      teammateGetResponse
+	Found         bool
+	HasRestricted bool
+	SubuserAccess []subuserAccessEntry
+}
+
+// Bounds for readTeammateSnapshotAfterWrite. Variables so tests can shorten
+// the delay.
+var (
+	postWriteReadAttempts = 5
+	postWriteReadDelay    = 2 * time.Second
+)
+
+// readTeammateSnapshotAfterWrite wraps readTeammateSnapshot for the read-back
+// right after POST/PATCH. SendGrid may briefly return 404 or an empty body
+// until the write propagates, so not-found/empty results are retried a few
+// times with a growing delay before being returned to the caller.
+func (r *SSOTeammateResource) readTeammateSnapshotAfterWrite(ctx context.Context, username string, expectAdmin bool, pageSize int64) (teammateSnapshot, diag.Diagnostics) {
+	delay := postWriteReadDelay
+	for attempt := 1; ; attempt++ {
+		// Retries must reach the API rather than the GET cache.
+		r.client.forgetGet("/v3/teammates/"+username, nil)
+		snap, diags := r.readTeammateSnapshot(ctx, username, expectAdmin, pageSize)
+		if diags.HasError() || (snap.Found && snap.Teammate.Username != "") || attempt >= postWriteReadAttempts {
+			return snap, diags
+		}
+		tflog.Debug(ctx, "Teammate not readable yet, retrying", map[string]any{"username": username, "attempt": attempt})
+		select {
+		case <-ctx.Done():
+			return snap, diags
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// readTeammateSnapshot reads GET /v3/teammates/{username} and, unless the
+// teammate turns out to be an admin, all pages of its subuser_access.
+//
+// The subuser_access endpoint uses an after_subuser_id cursor, so its pages
+// can only be fetched one after another. What can be overlapped is the
+// teammate GET itself: when the caller expects a non-admin (from plan or prior
+// state), the subuser_access pagination starts concurrently with it and the
+// result is discarded if the teammate is in fact an admin.
+func (r *SSOTeammateResource) readTeammateSnapshot(ctx context.Context, username string, expectAdmin bool, pageSize int64) (teammateSnapshot, diag.Diagnostics) {
+	var snap teammateSnapshot
+
+	var wg sync.WaitGroup
+	var saDiags diag.Diagnostics
+	if !expectAdmin {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			snap.HasRestricted, snap.SubuserAccess, saDiags = r.client.teammateSubuserAccess(ctx, username, "", pageSize)
+		}()
+	}
+
+	got, found, diags := r.readTeammate(ctx, username)
+	wg.Wait()
+	if diags.HasError() || !found {
+		return teammateSnapshot{}, diags
+	}
+	snap.Teammate = got
+	snap.Found = true
+
+	if got.IsAdmin {
+		snap.HasRestricted = false
+		snap.SubuserAccess = nil
+		return snap, diags
+	}
+	if expectAdmin {
+		snap.HasRestricted, snap.SubuserAccess, saDiags = r.client.teammateSubuserAccess(ctx, username, "", pageSize)
+	}
+	diags.Append(saDiags...)
+	return snap, diags
+}
+
+// readTeammate fetches a single teammate.
+// Returns (teammate, found, diags). found=false means the API returned 404.
+func (r *SSOTeammateResource) readTeammate(ctx context.Context, username string) (teammateGetResponse, bool, diag.Diagnostics) {
+	return r.client.readTeammate(ctx, username)
+}
+
+// readTeammate is GET /v3/teammates/{username} through the GET cache.
+// Returns (teammate, found, diags). found=false means the API returned 404.
+func (c *Client) readTeammate(ctx context.Context, username string) (teammateGetResponse, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tflog.Debug(ctx, "GET /v3/teammates/{username}", map[string]any{"username": username})
+	sgResp, err := c.cachedGet(ctx, "/v3/teammates/"+username, nil)
+	if err != nil {
+		diags.AddError("SendGrid API error", err.Error())
+		return teammateGetResponse{}, false, diags
+	}
+	diags.Append(deprecationWarnings("GET", "/v3/teammates/"+username, sgResp.Headers)...)
+	if sgResp.StatusCode == 404 {
+		return teammateGetResponse{}, false, diags
+	}
+	if sgResp.StatusCode >= 300 {
+		diags.AddError("Read teammate failed",
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+		return teammateGetResponse{}, false, diags
+	}
+
+	var got teammateGetResponse
+	if err := json.Unmarshal([]byte(sgResp.Body), &got); err != nil {
+		diags.AddError("Parse error (teammate)", fmt.Sprintf("unable to parse body: %v", err))
+		return teammateGetResponse{}, false, diags
+	}
+	return got, true, diags
+}
+
 // subuserAccessObjectType returns the types.ObjectType for subuser_access set elements.
 func subuserAccessObjectType() types.ObjectType {
 	return types.ObjectType{AttrTypes: map[string]attr.Type{
 		"id":              types.StringType,
+		"username":        types.StringType,
 		"permission_type": types.StringType,
+		"role":            types.StringType,
 		"scopes":          types.SetType{ElemType: types.StringType},
 	}}
 }
 
+// subuserAccessDetailsObjectType returns the types.ObjectType for
+// subuser_access_details map values.
+func subuserAccessDetailsObjectType() types.ObjectType {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"username": types.StringType,
+		"email":    types.StringType,
+		"disabled": types.BoolType,
+	}}
+}
+
+// subuserAccessDetailsToMap builds subuser_access_details from API entries,
+// keyed by subuser ID. Admin snapshots carry no entries and yield an empty map.
+func subuserAccessDetailsToMap(ctx context.Context, entries []subuserAccessEntry, diags *diag.Diagnostics) types.Map {
+	vals := make(map[string]attr.Value, len(entries))
+	for _, e := range entries {
+		obj, d := types.ObjectValue(subuserAccessDetailsObjectType().AttrTypes, map[string]attr.Value{
+			"username": types.StringValue(e.Username),
+			"email":    types.StringValue(e.Email),
+			"disabled": types.BoolValue(e.Disabled),
+		})
+		diags.Append(d...)
+		vals[strconv.FormatInt(e.ID, 10)] = obj
+	}
+	m, d := types.MapValue(subuserAccessDetailsObjectType(), vals)
+	diags.Append(d...)
+	return m
+}
+
 // subuserAccessEntriesToSet converts API entries to a types.Set for Terraform state.
 // Returns a null set when entries is empty.
 func subuserAccessEntriesToSet(ctx context.Context, entries []subuserAccessEntry, diags *diag.Diagnostics) types.Set {
@@ -744,7 +1340,15 @@ func subuserAccessEntriesToSet(ctx context.Context, entries []subuserAccessEntry
 	for _, e := range entries {
 		o := subuserAccessObject{
 			ID:             types.StringValue(strconv.FormatInt(e.ID, 10)),
+			Username:       types.StringNull(),
 			PermissionType: types.StringValue(e.PermissionType),
+			Role:           types.StringNull(),
+		}
+		if e.Username != "" {
+			o.Username = types.StringValue(e.Username)
+		}
+		if e.Role != "" {
+			o.Role = types.StringValue(e.Role)
 		}
 		if len(e.Scopes) > 0 {
 			setVals := make([]attr.Value, 0, len(e.Scopes))
@@ -762,6 +1366,56 @@ func subuserAccessEntriesToSet(ctx context.Context, entries []subuserAccessEntry
 	return sv
 }
 
+// dropRemoteScopeAdditions removes scopes that exist remotely but not in prior,
+// so out-of-band grants do not produce a diff. Scopes missing remotely are kept
+// out of the result, which lets Terraform plan to restore them. When prior is
+// null or unknown (e.g., on import) the remote scopes are returned unchanged.
+func dropRemoteScopeAdditions(ctx context.Context, remote []string, prior types.Set) []string {
+	if prior.IsNull() || prior.IsUnknown() {
+		return remote
+	}
+	var known []string
+	if d := prior.ElementsAs(ctx, &known, false); d.HasError() {
+		return remote
+	}
+	keep := make(map[string]struct{}, len(known))
+	for _, s := range known {
+		keep[s] = struct{}{}
+	}
+	out := make([]string, 0, len(remote))
+	for _, s := range remote {
+		if _, ok := keep[s]; ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// dropRemoteSubuserScopeAdditions applies dropRemoteScopeAdditions to each
+// subuser_access entry that also exists in prior (matched by subuser ID).
+// Entries unknown to prior are returned as-is.
+func dropRemoteSubuserScopeAdditions(ctx context.Context, entries []subuserAccessEntry, prior types.Set) []subuserAccessEntry {
+	if prior.IsNull() || prior.IsUnknown() {
+		return entries
+	}
+	var objs []subuserAccessObject
+	if d := prior.ElementsAs(ctx, &objs, false); d.HasError() {
+		return entries
+	}
+	priorScopes := make(map[string]types.Set, len(objs))
+	for _, o := range objs {
+		priorScopes[o.ID.ValueString()] = o.Scopes
+	}
+	out := make([]subuserAccessEntry, 0, len(entries))
+	for _, e := range entries {
+		if ps, ok := priorScopes[strconv.FormatInt(e.ID, 10)]; ok && !ps.IsNull() {
+			e.Scopes = dropRemoteScopeAdditions(ctx, e.Scopes, ps)
+		}
+		out = append(out, e)
+	}
+	return out
+}
+
 // scopesSliceToSet converts a []string of scopes to a types.Set.
 func scopesSliceToSet(scopes []string) types.Set {
 	if len(scopes) == 0 {
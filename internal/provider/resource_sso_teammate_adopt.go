@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sendgrid/sendgrid-go"
+)
+
+// NOTE: adopt_existing lets create take over a teammate that was not created
+// by this resource, e.g. a regular (password) teammate on an account moving to
+// SSO, instead of failing on the POST.
+//
+// API Endpoints:
+//   - Lookup:             GET    /v3/teammates?limit&offset
+//   - Existing teammate:  PATCH  /v3/sso/teammates/{username}
+//   - Pending invitation: DELETE /v3/teammates/pending/{token}, then the usual POST
+//
+// API Documentation:
+//   - Delete pending teammate: https://www.twilio.com/docs/sendgrid/api-reference/teammates/delete-pending-teammate
+//
+// The PATCH carries the whole planned configuration, so the adopted teammate's
+// access (including subuser_access) matches the plan once create finishes, and
+// later applies reconcile it like any other teammate.
+//
+// Regular teammates can have a username that differs from their email, so the
+// existing teammate is looked up by email in the teammate list. A username
+// other than the email is kept in private state and used by every later call.
+
+// adoptedUsernameKey is the private state key holding the username of an
+// adopted teammate whose username is not its email.
+const adoptedUsernameKey = "adopted_username"
+
+// teammateUsername returns the username to address the teammate with email
+// by: the adopted username recorded in private, or the email itself.
+func teammateUsername(ctx context.Context, private privateStateGetter, email string) string {
+	b, diags := private.GetKey(ctx, adoptedUsernameKey)
+	if diags.HasError() || len(b) == 0 {
+		return email
+	}
+	return string(b)
+}
+
+// adoptExistingTeammate runs before the create POST when adopt_existing is
+// set. When a teammate with payload.Email already exists it is PATCHed to
+// payload, adopted is true and username is the teammate's username, so the
+// POST must be skipped. When only a pending invitation exists, the invitation
+// is cancelled so the POST can create the SSO teammate in its place.
+func (r *SSOTeammateResource) adoptExistingTeammate(ctx context.Context, payload ssoCreatePayload) (username string, adopted bool, diags diag.Diagnostics) {
+	email := payload.Email
+
+	teammates, diags := r.client.listTeammates(ctx, "")
+	if diags.HasError() {
+		return "", false, diags
+	}
+	for _, tm := range teammates {
+		if !strings.EqualFold(tm.Email, email) {
+			continue
+		}
+		diags.Append(r.client.requireScopes(ctx, "adopt existing teammate", "teammates.update")...)
+		if diags.HasError() {
+			return "", false, diags
+		}
+		tflog.Info(ctx, "Adopting existing teammate", map[string]any{"username": tm.Username, "email": email})
+		diags.Append(r.patchTeammate(ctx, tm.Username, payload.patch())...)
+		return tm.Username, !diags.HasError(), diags
+	}
+
+	inv, pending, invDiags := r.client.findPendingTeammateInvite(ctx, email, "")
+	diags.Append(invDiags...)
+	if diags.HasError() || !pending {
+		return "", false, diags
+	}
+	diags.Append(r.client.requireScopes(ctx, "cancel pending teammate invitation", "teammates.delete")...)
+	if diags.HasError() {
+		return "", false, diags
+	}
+	tflog.Info(ctx, "Cancelling pending invitation before creating SSO teammate", map[string]any{"email": email})
+	diags.Append(r.client.deletePendingTeammate(ctx, inv.Token)...)
+	return "", false, diags
+}
+
+// patch returns the PATCH body that brings an existing teammate to the
+// configuration of p. has_restricted_subuser_access is always sent so access
+// granted outside Terraform is replaced rather than merged.
+func (p ssoCreatePayload) patch() ssoPatchPayload {
+	patch := ssoPatchPayload{
+		IsAdmin:       &p.IsAdmin,
+		Scopes:        p.Scopes,
+		HasRestricted: &p.HasRestricted,
+		SubuserAccess: p.SubuserAccess,
+	}
+	if p.FirstName != "" {
+		patch.FirstName = &p.FirstName
+	}
+	if p.LastName != "" {
+		patch.LastName = &p.LastName
+	}
+	if p.Persona != "" {
+		patch.Persona = &p.Persona
+	}
+	return patch
+}
+
+// deletePendingTeammate cancels a pending teammate invitation. An invitation
+// that is already gone (accepted or expired) is not an error.
+// DELETE /v3/teammates/pending/{token}
+func (c *Client) deletePendingTeammate(ctx context.Context, token string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	reqSG := sendgrid.GetRequest(c.APIKey, "/v3/teammates/pending/"+token, c.BaseURL)
+	reqSG.Method = "DELETE"
+	tflog.Debug(ctx, "DELETE /v3/teammates/pending/{token}")
+	sgResp, err := sendgrid.API(reqSG)
+	c.invalidateGetCache()
+	if err != nil {
+		diags.AddError("SendGrid API error", err.Error())
+		return diags
+	}
+	diags.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+	if sgResp.StatusCode >= 300 && sgResp.StatusCode != 404 {
+		diags.AddError("Delete pending teammate failed",
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+	}
+	return diags
+}
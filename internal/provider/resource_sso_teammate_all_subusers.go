@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// NOTE: all_subusers grants the same access to every subuser of the account.
+// The subuser list (GET /v3/subusers) is resolved at plan time into the
+// computed all_subusers.subuser_ids, so a subuser created after the last apply
+// shows up as a diff on the next plan and is granted access by the update.
+
+type allSubusersObject struct {
+	PermissionType types.String `tfsdk:"permission_type"`
+	Scopes         types.Set    `tfsdk:"scopes"`
+	SubuserIDs     types.Set    `tfsdk:"subuser_ids"`
+}
+
+func allSubusersObjectType() types.ObjectType {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"permission_type": types.StringType,
+		"scopes":          types.SetType{ElemType: types.StringType},
+		"subuser_ids":     types.SetType{ElemType: types.StringType},
+	}}
+}
+
+// allSubusers decodes the all_subusers block; ok is false when it is not set.
+func (m ssoTeammateModel) allSubusers(ctx context.Context, diags *diag.Diagnostics) (allSubusersObject, bool) {
+	var o allSubusersObject
+	if m.AllSubusers.IsNull() || m.AllSubusers.IsUnknown() {
+		return o, false
+	}
+	diags.Append(m.AllSubusers.As(ctx, &o, basetypes.ObjectAsOptions{})...)
+	return o, !diags.HasError()
+}
+
+// planAllSubuserIDs resolves all_subusers.subuser_ids in the plan to the IDs
+// of every current subuser.
+func (r *SSOTeammateResource) planAllSubuserIDs(ctx context.Context, plan *ssoTeammateModel, diags *diag.Diagnostics) {
+	o, ok := plan.allSubusers(ctx, diags)
+	if !ok {
+		return
+	}
+	subusers, listDiags := r.client.allSubusers(ctx)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return
+	}
+	ids := make([]string, 0, len(subusers))
+	for _, su := range subusers {
+		ids = append(ids, strconv.FormatInt(su.ID, 10))
+	}
+	o.SubuserIDs = scopesSliceToSet(ids)
+	plan.AllSubusers = allSubusersToObject(ctx, o, diags)
+}
+
+// allSubusersEntries expands all_subusers into one subuser_access entry per
+// planned subuser ID.
+func allSubusersEntries(ctx context.Context, o allSubusersObject, diags *diag.Diagnostics) []subuserAccessEntry {
+	var ids, scopes []string
+	if !o.SubuserIDs.IsNull() && !o.SubuserIDs.IsUnknown() {
+		diags.Append(o.SubuserIDs.ElementsAs(ctx, &ids, false)...)
+	}
+	if !o.Scopes.IsNull() && !o.Scopes.IsUnknown() {
+		diags.Append(o.Scopes.ElementsAs(ctx, &scopes, false)...)
+	}
+	if diags.HasError() {
+		return nil
+	}
+	sort.Strings(ids)
+	entries := make([]subuserAccessEntry, 0, len(ids))
+	for _, id := range ids {
+		idInt, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			diags.AddAttributeError(path.Root("all_subusers").AtName("subuser_ids"), "Invalid subuser ID", err.Error())
+			return nil
+		}
+		entries = append(entries, subuserAccessEntry{
+			ID:             idInt,
+			PermissionType: o.PermissionType.ValueString(),
+			Scopes:         scopes,
+		})
+	}
+	return entries
+}
+
+// refreshAllSubusers records in all_subusers.subuser_ids which subusers the
+// teammate currently holds the configured permission_type and scopes for.
+// Scopes SendGrid adds implicitly are ignored. Subusers missing from that set
+// are re-granted on the next apply.
+func refreshAllSubusers(ctx context.Context, m *ssoTeammateModel, remote []subuserAccessEntry, diags *diag.Diagnostics) {
+	o, ok := m.allSubusers(ctx, diags)
+	if !ok {
+		return
+	}
+	want := scopesSliceToSet(nil)
+	if !o.Scopes.IsNull() && !o.Scopes.IsUnknown() {
+		want = o.Scopes
+	}
+	ids := make([]string, 0, len(remote))
+	for _, e := range remote {
+		if e.PermissionType != o.PermissionType.ValueString() {
+			continue
+		}
+		if e.PermissionType != "admin" && !scopesSliceToSet(dropImpliedScopes(ctx, e.Scopes, want)).Equal(want) {
+			continue
+		}
+		ids = append(ids, strconv.FormatInt(e.ID, 10))
+	}
+	o.SubuserIDs = scopesSliceToSet(ids)
+	m.AllSubusers = allSubusersToObject(ctx, o, diags)
+}
+
+func allSubusersToObject(ctx context.Context, o allSubusersObject, diags *diag.Diagnostics) types.Object {
+	obj, d := types.ObjectValueFrom(ctx, allSubusersObjectType().AttrTypes, o)
+	diags.Append(d...)
+	return obj
+}
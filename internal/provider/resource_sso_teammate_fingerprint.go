@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// NOTE: subuser_access_fast_refresh keeps a fingerprint of the first page of
+// GET /v3/teammates/{username}/subuser_access in private state. On refresh,
+// only that page is fetched; when its fingerprint matches, the remaining pages
+// are assumed unchanged and subuser_access is kept from state instead of
+// paginating through thousands of entries. A change confined to later pages
+// is therefore only seen once the first page changes too, or after the next
+// apply of the resource (which always reads every page).
+
+// subuserAccessFingerprintKey is the private state key of the fingerprint.
+const subuserAccessFingerprintKey = "subuser_access_fingerprint"
+
+// subuserAccessFingerprint identifies the first page of a teammate's subuser
+// access as read with PageSize.
+type subuserAccessFingerprint struct {
+	PageSize int64  `json:"page_size"`
+	Hash     string `json:"hash"`
+}
+
+// privateStateGetter and privateStateSetter are implemented by the Private
+// fields of the framework requests and responses.
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// newSubuserAccessFingerprint hashes has_restricted_subuser_access and the
+// first pageSize entries, i.e. what the first page of a read returns.
+func newSubuserAccessFingerprint(hasRestricted bool, entries []subuserAccessEntry, pageSize int64) subuserAccessFingerprint {
+	if int64(len(entries)) > pageSize {
+		entries = entries[:pageSize]
+	}
+	type hashedEntry struct {
+		ID             int64    `json:"id"`
+		PermissionType string   `json:"permission_type"`
+		Scopes         []string `json:"scopes"`
+		Username       string   `json:"username"`
+		Email          string   `json:"email"`
+		Disabled       bool     `json:"disabled"`
+	}
+	page := make([]hashedEntry, 0, len(entries))
+	for _, e := range entries {
+		page = append(page, hashedEntry{e.ID, e.PermissionType, e.Scopes, e.Username, e.Email, e.Disabled})
+	}
+	b, _ := json.Marshal(struct {
+		HasRestricted bool          `json:"has_restricted_subuser_access"`
+		Page          []hashedEntry `json:"page"`
+	}{hasRestricted, page})
+	sum := sha256.Sum256(b)
+	return subuserAccessFingerprint{PageSize: pageSize, Hash: hex.EncodeToString(sum[:])}
+}
+
+// loadSubuserAccessFingerprint returns the stored fingerprint, or nil when
+// there is none or it cannot be decoded.
+func loadSubuserAccessFingerprint(ctx context.Context, private privateStateGetter) *subuserAccessFingerprint {
+	b, diags := private.GetKey(ctx, subuserAccessFingerprintKey)
+	if diags.HasError() || len(b) == 0 {
+		return nil
+	}
+	var fp subuserAccessFingerprint
+	if err := json.Unmarshal(b, &fp); err != nil {
+		tflog.Debug(ctx, "Ignoring undecodable subuser_access fingerprint", map[string]any{"error": err.Error()})
+		return nil
+	}
+	return &fp
+}
+
+// recordSubuserAccessFingerprint stores the fingerprint of a fully read snap
+// when m enables subuser_access_fast_refresh, and clears it otherwise.
+func recordSubuserAccessFingerprint(ctx context.Context, private privateStateSetter, m ssoTeammateModel, snap teammateSnapshot) diag.Diagnostics {
+	if !m.SubuserAccessFastRefresh.ValueBool() || !snap.Found || snap.Teammate.IsAdmin {
+		return private.SetKey(ctx, subuserAccessFingerprintKey, nil)
+	}
+	b, _ := json.Marshal(newSubuserAccessFingerprint(snap.HasRestricted, snap.SubuserAccess, m.subuserAccessPageSize()))
+	return private.SetKey(ctx, subuserAccessFingerprintKey, b)
+}
+
+// readTeammateSnapshotIfChanged is readTeammateSnapshot for refreshes with
+// subuser_access_fast_refresh. When the first subuser_access page still
+// matches stored, unchanged is true and snap carries no subuser access: the
+// caller keeps what is in state. Otherwise the remaining pages are read and
+// snap is complete.
+func (r *SSOTeammateResource) readTeammateSnapshotIfChanged(ctx context.Context, username string, pageSize int64, stored *subuserAccessFingerprint) (snap teammateSnapshot, unchanged bool, diags diag.Diagnostics) {
+	if stored == nil || stored.PageSize != pageSize {
+		snap, diags = r.readTeammateSnapshot(ctx, username, false, pageSize)
+		return snap, false, diags
+	}
+
+	got, found, diags := r.readTeammate(ctx, username)
+	if diags.HasError() || !found {
+		return teammateSnapshot{}, false, diags
+	}
+	snap.Teammate = got
+	snap.Found = true
+	if got.IsAdmin {
+		return snap, false, diags
+	}
+
+	hasRestricted, entries, next, pageDiags := r.client.teammateSubuserAccessPage(ctx, username, "", pageSize, 0)
+	diags.Append(pageDiags...)
+	if diags.HasError() {
+		return teammateSnapshot{}, false, diags
+	}
+	if newSubuserAccessFingerprint(hasRestricted, entries, pageSize) == *stored {
+		tflog.Debug(ctx, "subuser_access first page unchanged; keeping state", map[string]any{"username": username})
+		snap.HasRestricted = hasRestricted
+		return snap, true, diags
+	}
+
+	for next != 0 {
+		var page []subuserAccessEntry
+		hasRestricted, page, next, pageDiags = r.client.teammateSubuserAccessPage(ctx, username, "", pageSize, next)
+		diags.Append(pageDiags...)
+		if diags.HasError() {
+			return teammateSnapshot{}, false, diags
+		}
+		entries = append(entries, page...)
+	}
+	snap.HasRestricted = hasRestricted
+	snap.SubuserAccess = entries
+	return snap, false, diags
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// memPrivateState is an in-memory stand-in for the framework's private state.
+type memPrivateState map[string][]byte
+
+func (m memPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return m[key], nil
+}
+
+func (m memPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	if len(value) == 0 {
+		delete(m, key)
+		return nil
+	}
+	m[key] = value
+	return nil
+}
+
+func TestSubuserAccessFingerprint_FirstPageOnly(t *testing.T) {
+	entries := []subuserAccessEntry{
+		{ID: 1, PermissionType: "admin"},
+		{ID: 2, PermissionType: "restricted", Scopes: []string{"stats.read"}},
+	}
+	fp := newSubuserAccessFingerprint(true, entries, 1)
+	if fp != newSubuserAccessFingerprint(true, entries[:1], 1) {
+		t.Fatal("entries beyond the first page must not affect the fingerprint")
+	}
+	if fp == newSubuserAccessFingerprint(false, entries, 1) {
+		t.Fatal("has_restricted_subuser_access must affect the fingerprint")
+	}
+	if fp == newSubuserAccessFingerprint(true, entries, 2) {
+		t.Fatal("page size must affect the fingerprint")
+	}
+}
+
+func TestRecordSubuserAccessFingerprint(t *testing.T) {
+	ctx := context.Background()
+	private := memPrivateState{}
+	snap := teammateSnapshot{Found: true, HasRestricted: true, SubuserAccess: []subuserAccessEntry{{ID: 1, PermissionType: "admin"}}}
+
+	m := ssoTeammateModel{SubuserAccessFastRefresh: types.BoolValue(true)}
+	if diags := recordSubuserAccessFingerprint(ctx, private, m, snap); diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	got := loadSubuserAccessFingerprint(ctx, private)
+	if want := newSubuserAccessFingerprint(true, snap.SubuserAccess, defaultSubuserAccessPageSize); got == nil || *got != want {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+
+	m.SubuserAccessFastRefresh = types.BoolNull()
+	_ = recordSubuserAccessFingerprint(ctx, private, m, snap)
+	if got := loadSubuserAccessFingerprint(ctx, private); got != nil {
+		t.Fatalf("fingerprint should be cleared, got %+v", got)
+	}
+}
+
+func TestReadTeammateSnapshotIfChanged(t *testing.T) {
+	ids := []int64{1, 2, 3}
+	srv := newSubuserAccessServer(t, false, ids)
+	defer srv.Close()
+
+	var requests []string
+	inner := srv.Config.Handler
+	srv.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/subuser_access") {
+			requests = append(requests, r.URL.RequestURI())
+		}
+		inner.ServeHTTP(w, r)
+	})
+
+	ctx := context.Background()
+	r := &SSOTeammateResource{client: &Client{BaseURL: srv.URL, APIKey: "test-key"}}
+
+	// No fingerprint: every page is read.
+	snap, unchanged, diags := r.readTeammateSnapshotIfChanged(ctx, "someone@example.com", 1, nil)
+	if diags.HasError() || unchanged || len(snap.SubuserAccess) != len(ids) {
+		t.Fatalf("unexpected result: unchanged=%v snap=%+v diags=%v", unchanged, snap, diags)
+	}
+
+	// Matching first page: only that page is read.
+	fp := newSubuserAccessFingerprint(snap.HasRestricted, snap.SubuserAccess, 1)
+	requests = nil
+	snap, unchanged, diags = r.readTeammateSnapshotIfChanged(ctx, "someone@example.com", 1, &fp)
+	if diags.HasError() || !unchanged || snap.SubuserAccess != nil {
+		t.Fatalf("unexpected result: unchanged=%v snap=%+v diags=%v", unchanged, snap, diags)
+	}
+	if len(requests) != 1 {
+		t.Fatalf("expected one subuser_access page, got %v", requests)
+	}
+
+	// Stale fingerprint: the remaining pages are read.
+	stale := subuserAccessFingerprint{PageSize: 1, Hash: "stale"}
+	snap, unchanged, diags = r.readTeammateSnapshotIfChanged(ctx, "someone@example.com", 1, &stale)
+	if diags.HasError() || unchanged || len(snap.SubuserAccess) != len(ids) {
+		t.Fatalf("unexpected result: unchanged=%v snap=%+v diags=%v", unchanged, snap, diags)
+	}
+}
@@ -0,0 +1,529 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDropRemoteScopeAdditions(t *testing.T) {
+	ctx := context.Background()
+	prior := scopesSliceToSet([]string{"stats.read", "messages.read"})
+
+	// Extra remote scope is dropped; a scope removed remotely stays missing so
+	// the next plan restores it.
+	got := dropRemoteScopeAdditions(ctx, []string{"stats.read", "mail_settings.read"}, prior)
+	if want := []string{"stats.read"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	// Without prior state (import) the remote value is taken as-is.
+	remote := []string{"stats.read", "mail_settings.read"}
+	got = dropRemoteScopeAdditions(ctx, remote, types.SetNull(types.StringType))
+	if !reflect.DeepEqual(got, remote) {
+		t.Fatalf("got %v, want %v", got, remote)
+	}
+}
+
+func TestDropRemoteSubuserScopeAdditions(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+	prior := subuserAccessEntriesToSet(ctx, []subuserAccessEntry{
+		{ID: 1, PermissionType: "restricted", Scopes: []string{"stats.read"}},
+	}, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+
+	got := dropRemoteSubuserScopeAdditions(ctx, []subuserAccessEntry{
+		{ID: 1, PermissionType: "restricted", Scopes: []string{"stats.read", "messages.read"}},
+		{ID: 2, PermissionType: "restricted", Scopes: []string{"messages.read"}},
+	}, prior)
+
+	want := []subuserAccessEntry{
+		{ID: 1, PermissionType: "restricted", Scopes: []string{"stats.read"}},
+		{ID: 2, PermissionType: "restricted", Scopes: []string{"messages.read"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+// newSubuserAccessServer serves a teammate and its subuser_access split into
+// pages of one entry each, so pagination is exercised.
+func newSubuserAccessServer(t *testing.T, isAdmin bool, ids []int64) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v3/teammates/someone@example.com", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"username": "someone@example.com",
+			"email":    "someone@example.com",
+			"status":   "active",
+			"is_admin": isAdmin,
+		})
+	})
+	mux.HandleFunc("/v3/teammates/someone@example.com/subuser_access", func(w http.ResponseWriter, r *http.Request) {
+		after, _ := strconv.ParseInt(r.URL.Query().Get("after_subuser_id"), 10, 64)
+		idx := 0
+		for idx < len(ids) && ids[idx] <= after {
+			idx++
+		}
+		page := []map[string]any{}
+		var next int64
+		if idx < len(ids) {
+			page = append(page, map[string]any{
+				"id":              ids[idx],
+				"username":        "sub" + strconv.FormatInt(ids[idx], 10),
+				"email":           "sub" + strconv.FormatInt(ids[idx], 10) + "@example.com",
+				"disabled":        ids[idx] == 22,
+				"permission_type": "admin",
+			})
+			if idx+1 < len(ids) {
+				next = ids[idx]
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"has_restricted_subuser_access": true,
+			"subuser_access":                page,
+			"_metadata":                     map[string]any{"next_params": map[string]any{"after_subuser_id": next}},
+		})
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestReadTeammateSnapshot_Paginates(t *testing.T) {
+	srv := newSubuserAccessServer(t, false, []int64{11, 22, 33})
+	defer srv.Close()
+
+	r := &SSOTeammateResource{client: &Client{BaseURL: srv.URL, APIKey: "test-key"}}
+	for _, expectAdmin := range []bool{false, true} {
+		snap, diags := r.readTeammateSnapshot(context.Background(), "someone@example.com", expectAdmin, defaultSubuserAccessPageSize)
+		if diags.HasError() {
+			t.Fatalf("unexpected diags: %v", diags)
+		}
+		if !snap.Found || !snap.HasRestricted {
+			t.Fatalf("unexpected snapshot: %+v", snap)
+		}
+		if len(snap.SubuserAccess) != 3 || snap.SubuserAccess[2].ID != 33 {
+			t.Fatalf("expected 3 entries across pages, got %+v", snap.SubuserAccess)
+		}
+	}
+}
+
+func TestSubuserAccessDetailsToMap(t *testing.T) {
+	srv := newSubuserAccessServer(t, false, []int64{11, 22})
+	defer srv.Close()
+
+	r := &SSOTeammateResource{client: &Client{BaseURL: srv.URL, APIKey: "test-key"}}
+	snap, diags := r.readTeammateSnapshot(context.Background(), "someone@example.com", false, defaultSubuserAccessPageSize)
+	details := subuserAccessDetailsToMap(context.Background(), snap.SubuserAccess, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	got, ok := details.Elements()["22"].(types.Object)
+	if !ok {
+		t.Fatalf("missing subuser 22 in %v", details)
+	}
+	attrs := got.Attributes()
+	if attrs["username"].(types.String).ValueString() != "sub22" || !attrs["disabled"].(types.Bool).ValueBool() {
+		t.Fatalf("unexpected details: %v", attrs)
+	}
+}
+
+func TestReadTeammateSnapshot_AdminSkipsSubuserAccess(t *testing.T) {
+	srv := newSubuserAccessServer(t, true, []int64{11})
+	defer srv.Close()
+
+	r := &SSOTeammateResource{client: &Client{BaseURL: srv.URL, APIKey: "test-key"}}
+	snap, diags := r.readTeammateSnapshot(context.Background(), "someone@example.com", false, defaultSubuserAccessPageSize)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if snap.HasRestricted || snap.SubuserAccess != nil {
+		t.Fatalf("admin snapshot should not carry subuser_access: %+v", snap)
+	}
+}
+
+func TestWaitForTeammateActive(t *testing.T) {
+	prev := teammateStatusPollInterval
+	teammateStatusPollInterval = time.Millisecond
+	defer func() { teammateStatusPollInterval = prev }()
+
+	polls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		switch {
+		case polls == 1:
+			// Not yet readable right after create.
+			w.WriteHeader(http.StatusNotFound)
+		case polls < 4:
+			_ = json.NewEncoder(w).Encode(map[string]any{"username": "someone@example.com", "status": "pending"})
+		default:
+			_ = json.NewEncoder(w).Encode(map[string]any{"username": "someone@example.com", "status": "active"})
+		}
+	}))
+	defer srv.Close()
+
+	r := &SSOTeammateResource{client: &Client{BaseURL: srv.URL, APIKey: "test-key"}}
+	if diags := r.waitForTeammateActive(context.Background(), "someone@example.com", time.Minute); diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if polls != 4 {
+		t.Fatalf("expected 4 polls, got %d", polls)
+	}
+
+	// A teammate stuck in pending fails once the timeout elapses.
+	polls = 1
+	srv.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"username": "someone@example.com", "status": "pending"})
+	})
+	if diags := r.waitForTeammateActive(context.Background(), "someone@example.com", 5*time.Millisecond); !diags.HasError() {
+		t.Fatal("expected timeout error")
+	}
+}
+
+func TestDurationValidator(t *testing.T) {
+	for value, wantErr := range map[string]bool{
+		"5m":     false,
+		"1h30m":  false,
+		"10":     true,
+		"5 mins": true,
+		"0s":     true,
+		"-5m":    true,
+	} {
+		req := validator.StringRequest{Path: path.Root("wait_timeout"), ConfigValue: types.StringValue(value)}
+		var resp validator.StringResponse
+		durationValidator{}.ValidateString(context.Background(), req, &resp)
+		if got := resp.Diagnostics.HasError(); got != wantErr {
+			t.Errorf("%q: error = %v, want %v", value, got, wantErr)
+		}
+	}
+}
+
+func TestReadTeammateSnapshotAfterWrite_RetriesNotFound(t *testing.T) {
+	prev := postWriteReadDelay
+	postWriteReadDelay = time.Millisecond
+	defer func() { postWriteReadDelay = prev }()
+
+	gets := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/teammates/someone@example.com" {
+			_, _ = w.Write([]byte(`{"subuser_access":[]}`))
+			return
+		}
+		gets++
+		switch gets {
+		case 1:
+			w.WriteHeader(http.StatusNotFound)
+		case 2:
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			_ = json.NewEncoder(w).Encode(map[string]any{"username": "someone@example.com", "status": "active", "is_admin": true})
+		}
+	}))
+	defer srv.Close()
+
+	r := &SSOTeammateResource{client: &Client{BaseURL: srv.URL, APIKey: "test-key"}}
+	snap, diags := r.readTeammateSnapshotAfterWrite(context.Background(), "someone@example.com", true, defaultSubuserAccessPageSize)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if !snap.Found || gets != 3 {
+		t.Fatalf("expected found after 3 GETs, got found=%v gets=%d", snap.Found, gets)
+	}
+}
+
+func TestRefreshAllSubusers_TracksGrantedSubusers(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+	m := ssoTeammateModel{AllSubusers: allSubusersToObject(ctx, allSubusersObject{
+		PermissionType: types.StringValue("restricted"),
+		Scopes:         scopesSliceToSet([]string{"stats.read"}),
+		SubuserIDs:     scopesSliceToSet([]string{"1", "2", "3"}),
+	}, &diags)}
+
+	// Subuser 3 lost access remotely and 2 was switched to admin, so only 1
+	// still counts as covered.
+	refreshAllSubusers(ctx, &m, []subuserAccessEntry{
+		{ID: 1, PermissionType: "restricted", Scopes: []string{"stats.read"}},
+		{ID: 2, PermissionType: "admin"},
+	}, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	all, _ := m.allSubusers(ctx, &diags)
+	if want := scopesSliceToSet([]string{"1"}); !all.SubuserIDs.Equal(want) {
+		t.Fatalf("got %v, want %v", all.SubuserIDs, want)
+	}
+
+	// Subuser 2 lost one of the configured scopes, so only 1 is covered; the
+	// implied templates.read and 2fa_required on subuser 1 are not differences.
+	m.AllSubusers = allSubusersToObject(ctx, allSubusersObject{
+		PermissionType: types.StringValue("restricted"),
+		Scopes:         scopesSliceToSet([]string{"mail.send", "templates.create"}),
+		SubuserIDs:     scopesSliceToSet([]string{"1", "2"}),
+	}, &diags)
+	refreshAllSubusers(ctx, &m, []subuserAccessEntry{
+		{ID: 1, PermissionType: "restricted", Scopes: []string{"2fa_required", "mail.send", "templates.create", "templates.read"}},
+		{ID: 2, PermissionType: "restricted", Scopes: []string{"mail.send"}},
+	}, &diags)
+	all, _ = m.allSubusers(ctx, &diags)
+	if want := scopesSliceToSet([]string{"1"}); !all.SubuserIDs.Equal(want) {
+		t.Fatalf("got %v, want %v", all.SubuserIDs, want)
+	}
+
+	entries := allSubusersEntries(ctx, allSubusersObject{
+		PermissionType: types.StringValue("restricted"),
+		Scopes:         scopesSliceToSet([]string{"stats.read"}),
+		SubuserIDs:     scopesSliceToSet([]string{"2", "1"}),
+	}, &diags)
+	if len(entries) != 2 || entries[0].ID != 1 || entries[1].Scopes[0] != "stats.read" {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+}
+
+func TestExpandSubuserAccessRoles(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+	cfg, d := types.SetValueFrom(ctx, subuserAccessObjectType(), []subuserAccessObject{
+		{ID: types.StringValue("1"), PermissionType: types.StringValue("restricted"), Role: types.StringValue("billing"), Scopes: types.SetNull(types.StringType)},
+		{ID: types.StringValue("2"), PermissionType: types.StringValue("restricted"), Role: types.StringNull(), Scopes: scopesSliceToSet([]string{"stats.read"})},
+	})
+	diags.Append(d...)
+
+	expanded, ok := expandSubuserAccessRoles(ctx, cfg, &diags)
+	if !ok || diags.HasError() {
+		t.Fatalf("expansion failed: %v", diags)
+	}
+	var objs []subuserAccessObject
+	diags.Append(expanded.ElementsAs(ctx, &objs, false)...)
+	for _, o := range objs {
+		want := scopesSliceToSet([]string{"stats.read"})
+		if o.ID.ValueString() == "1" {
+			want = scopesSliceToSet(subuserRoleScopes["billing"])
+		}
+		if !o.Scopes.Equal(want) {
+			t.Fatalf("subuser %s: got %v, want %v", o.ID.ValueString(), o.Scopes, want)
+		}
+	}
+
+	// The API does not return roles, so they are carried over from prior state.
+	got := carrySubuserAccessRoles(ctx, []subuserAccessEntry{{ID: 1}, {ID: 2}}, expanded)
+	if got[0].Role != "billing" || got[1].Role != "" {
+		t.Fatalf("unexpected roles: %+v", got)
+	}
+}
+
+func TestRevokeTeammateAccess(t *testing.T) {
+	var got map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/v3/sso/teammates/someone@example.com" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	r := &SSOTeammateResource{client: &Client{BaseURL: srv.URL, APIKey: "test-key"}}
+	if diags := r.revokeTeammateAccess(context.Background(), "someone@example.com"); diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	want := map[string]any{"is_admin": false, "scopes": []any{}, "has_restricted_subuser_access": false}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestResolveSubuserAccessKeys(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode([]subuserAPI{{ID: 1, Username: "alpha"}, {ID: 2, Username: "beta"}})
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	r := &SSOTeammateResource{client: &Client{BaseURL: srv.URL, APIKey: "test-key"}}
+	planned, _ := types.SetValueFrom(ctx, subuserAccessObjectType(), []subuserAccessObject{
+		{ID: types.StringNull(), Username: types.StringValue("alpha"), PermissionType: types.StringValue("admin"), Scopes: types.SetNull(types.StringType)},
+		{ID: types.StringValue("2"), Username: types.StringNull(), PermissionType: types.StringValue("admin"), Scopes: types.SetNull(types.StringType)},
+	})
+
+	var diags diag.Diagnostics
+	got := r.resolveSubuserAccessKeys(ctx, planned, types.SetNull(subuserAccessObjectType()), &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	var objs []subuserAccessObject
+	diags.Append(got.ElementsAs(ctx, &objs, false)...)
+	resolved := map[string]string{}
+	for _, o := range objs {
+		resolved[o.ID.ValueString()] = o.Username.ValueString()
+	}
+	if want := map[string]string{"1": "alpha", "2": "beta"}; !reflect.DeepEqual(resolved, want) {
+		t.Fatalf("got %v, want %v", resolved, want)
+	}
+
+	unknown, _ := types.SetValueFrom(ctx, subuserAccessObjectType(), []subuserAccessObject{
+		{ID: types.StringNull(), Username: types.StringValue("gamma"), PermissionType: types.StringValue("admin"), Scopes: types.SetNull(types.StringType)},
+	})
+	r.resolveSubuserAccessKeys(ctx, unknown, types.SetNull(subuserAccessObjectType()), &diags)
+	if !diags.HasError() {
+		t.Fatal("expected an error for an unknown username")
+	}
+}
+
+func TestPatchTeammate_SendsWholeSubuserAccessList(t *testing.T) {
+	// The mock applies the API's replace semantics: every PATCH that carries
+	// subuser_access overwrites the remote list.
+	var remote []any
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if sa, ok := body["subuser_access"].([]any); ok {
+			remote = sa
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	first := "Someone"
+	restricted := true
+	patch := ssoPatchPayload{FirstName: &first, HasRestricted: &restricted}
+	for id := int64(1); id <= 2500; id++ {
+		patch.SubuserAccess = append(patch.SubuserAccess, subuserAccessEntry{ID: id, PermissionType: "admin"})
+	}
+
+	r := &SSOTeammateResource{client: &Client{BaseURL: srv.URL, APIKey: "test-key"}}
+	if diags := r.patchTeammate(context.Background(), "someone@example.com", patch); diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if calls != 1 {
+		t.Fatalf("expected a single PATCH, got %d", calls)
+	}
+	if len(remote) != 2500 {
+		t.Fatalf("remote subuser access has %d entries, want 2500", len(remote))
+	}
+}
+
+func TestPatchTeammate_PayloadTooLarge(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		_, _ = w.Write([]byte(`{"errors":[{"message":"request entity too large"}]}`))
+	}))
+	defer srv.Close()
+
+	restricted := true
+	patch := ssoPatchPayload{HasRestricted: &restricted}
+	for id := int64(1); id <= 3; id++ {
+		patch.SubuserAccess = append(patch.SubuserAccess, subuserAccessEntry{ID: id, PermissionType: "admin"})
+	}
+
+	r := &SSOTeammateResource{client: &Client{BaseURL: srv.URL, APIKey: "test-key"}}
+	diags := r.patchTeammate(context.Background(), "someone@example.com", patch)
+	if !diags.HasError() {
+		t.Fatal("expected an error")
+	}
+	if got := diags[0].Summary(); got != "subuser_access is too large for a single update" {
+		t.Fatalf("unexpected summary %q", got)
+	}
+	if !strings.Contains(diags[0].Detail(), "3 subuser_access entries") {
+		t.Fatalf("detail does not name the entry count: %q", diags[0].Detail())
+	}
+}
+
+func TestPayloadTooLarge(t *testing.T) {
+	for _, tc := range []struct {
+		status int
+		body   string
+		want   bool
+	}{
+		{413, "", true},
+		{400, `{"errors":[{"message":"Request body exceeds the maximum size"}]}`, true},
+		{400, `{"errors":[{"message":"invalid scope","field":"scopes"}]}`, false},
+		{500, `{"errors":[{"message":"too large"}]}`, false},
+	} {
+		if got := payloadTooLarge(tc.status, tc.body); got != tc.want {
+			t.Errorf("payloadTooLarge(%d, %s) = %v, want %v", tc.status, tc.body, got, tc.want)
+		}
+	}
+}
+
+func TestAdoptExistingTeammate(t *testing.T) {
+	var patched map[string]any
+	var deleted []string
+	mux := http.NewServeMux()
+	var patchedPaths []string
+	mux.HandleFunc("/v3/teammates", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"result":[
+			{"username":"existing@example.com","email":"existing@example.com","user_type":"teammate"},
+			{"username":"jdoe","email":"John.Doe@example.com","user_type":"teammate"}
+		]}`))
+	})
+	mux.HandleFunc("/v3/sso/teammates/", func(w http.ResponseWriter, r *http.Request) {
+		patchedPaths = append(patchedPaths, r.Method+" "+r.URL.Path)
+		_ = json.NewDecoder(r.Body).Decode(&patched)
+		_, _ = w.Write([]byte(`{}`))
+	})
+	mux.HandleFunc("/v3/teammates/pending", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"result":[{"email":"Invited@example.com","token":"tok-1"}]}`))
+	})
+	mux.HandleFunc("/v3/teammates/pending/", func(w http.ResponseWriter, r *http.Request) {
+		deleted = append(deleted, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	ctx := context.Background()
+	r := &SSOTeammateResource{client: &Client{BaseURL: srv.URL, APIKey: "test-key"}}
+
+	// An existing teammate is PATCHed and the POST is skipped.
+	username, adopted, diags := r.adoptExistingTeammate(ctx, ssoCreatePayload{Email: "existing@example.com", Scopes: []string{"stats.read"}})
+	if diags.HasError() || !adopted || username != "existing@example.com" {
+		t.Fatalf("existing: username=%q adopted=%v diags=%v", username, adopted, diags)
+	}
+	want := map[string]any{"is_admin": false, "scopes": []any{"stats.read"}, "has_restricted_subuser_access": false}
+	if !reflect.DeepEqual(patched, want) {
+		t.Fatalf("got PATCH %v, want %v", patched, want)
+	}
+
+	// A regular teammate whose username is not its email is PATCHed under
+	// its username, matched on email case-insensitively.
+	patchedPaths = nil
+	username, adopted, diags = r.adoptExistingTeammate(ctx, ssoCreatePayload{Email: "john.doe@example.com"})
+	if diags.HasError() || !adopted || username != "jdoe" {
+		t.Fatalf("username differs: username=%q adopted=%v diags=%v", username, adopted, diags)
+	}
+	if want := []string{"PATCH /v3/sso/teammates/jdoe"}; !reflect.DeepEqual(patchedPaths, want) {
+		t.Fatalf("got %v, want %v", patchedPaths, want)
+	}
+
+	// A pending invitation is cancelled and the POST still happens.
+	_, adopted, diags = r.adoptExistingTeammate(ctx, ssoCreatePayload{Email: "invited@example.com"})
+	if diags.HasError() || adopted {
+		t.Fatalf("pending: adopted=%v diags=%v", adopted, diags)
+	}
+	if want := []string{"DELETE /v3/teammates/pending/tok-1"}; !reflect.DeepEqual(deleted, want) {
+		t.Fatalf("got %v, want %v", deleted, want)
+	}
+
+	// Nothing to adopt.
+	deleted = nil
+	_, adopted, diags = r.adoptExistingTeammate(ctx, ssoCreatePayload{Email: "new@example.com"})
+	if diags.HasError() || adopted || len(deleted) != 0 {
+		t.Fatalf("new: adopted=%v deleted=%v diags=%v", adopted, deleted, diags)
+	}
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// NOTE: Role presets for subuser_access entries. A role is expanded into
+// subuser_access.scopes at plan time, so the granted scopes stay visible in
+// plan and state. When a preset changes in a newer provider release, the
+// expanded scopes differ from state and the next apply updates the teammate.
+
+var subuserRoleScopes = map[string][]string{
+	"observer": {
+		"alerts.read",
+		"categories.read",
+		"mail_settings.read",
+		"stats.global.read",
+		"stats.read",
+		"suppression.read",
+		"templates.read",
+		"tracking_settings.read",
+		"user.profile.read",
+	},
+	"developer": {
+		"alerts.create",
+		"alerts.read",
+		"alerts.update",
+		"categories.read",
+		"mail.send",
+		"mail_settings.read",
+		"stats.global.read",
+		"stats.read",
+		"suppression.read",
+		"templates.create",
+		"templates.delete",
+		"templates.read",
+		"templates.update",
+		"templates.versions.activate.create",
+		"templates.versions.create",
+		"templates.versions.delete",
+		"templates.versions.read",
+		"templates.versions.update",
+		"tracking_settings.read",
+		"user.profile.read",
+		"user.webhooks.event.settings.read",
+		"user.webhooks.event.settings.update",
+	},
+	"billing": {
+		"billing.read",
+		"billing.update",
+		"user.account.read",
+		"user.credits.read",
+		"user.profile.read",
+	},
+}
+
+// subuserRoleNames returns the supported role names in a stable order.
+func subuserRoleNames() []string {
+	names := make([]string, 0, len(subuserRoleScopes))
+	for name := range subuserRoleScopes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// expandSubuserAccessRoles returns the configured subuser_access set with the
+// scopes of every entry that sets `role` replaced by the role's preset. Entries
+// without a role are returned as configured. ok is false when the set (or any
+// element) is not yet known.
+func expandSubuserAccessRoles(ctx context.Context, set types.Set, diags *diag.Diagnostics) (types.Set, bool) {
+	if set.IsNull() || set.IsUnknown() {
+		return set, false
+	}
+	objs := make([]subuserAccessObject, 0, len(set.Elements()))
+	for _, elem := range set.Elements() {
+		obj, ok := elem.(types.Object)
+		if !ok || obj.IsUnknown() {
+			return set, false
+		}
+		var o subuserAccessObject
+		diags.Append(obj.As(ctx, &o, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return set, false
+		}
+		if o.Role.IsUnknown() {
+			return set, false
+		}
+		if !o.Role.IsNull() {
+			scopes := subuserRoleScopes[o.Role.ValueString()]
+			vals := make([]attr.Value, 0, len(scopes))
+			for _, s := range scopes {
+				vals = append(vals, types.StringValue(s))
+			}
+			o.Scopes = types.SetValueMust(types.StringType, vals)
+		}
+		objs = append(objs, o)
+	}
+	out, d := types.SetValueFrom(ctx, subuserAccessObjectType(), objs)
+	diags.Append(d...)
+	return out, !diags.HasError()
+}
+
+// carrySubuserAccessRoles copies `role` from prior onto remote entries with the
+// same subuser ID. The API only returns scopes, so without this every read
+// would drop the role from state.
+func carrySubuserAccessRoles(ctx context.Context, entries []subuserAccessEntry, prior types.Set) []subuserAccessEntry {
+	if prior.IsNull() || prior.IsUnknown() {
+		return entries
+	}
+	var objs []subuserAccessObject
+	if d := prior.ElementsAs(ctx, &objs, false); d.HasError() {
+		return entries
+	}
+	roles := make(map[string]string, len(objs))
+	for _, o := range objs {
+		if !o.Role.IsNull() && !o.Role.IsUnknown() {
+			roles[o.ID.ValueString()] = o.Role.ValueString()
+		}
+	}
+	out := make([]subuserAccessEntry, 0, len(entries))
+	for _, e := range entries {
+		e.Role = roles[strconv.FormatInt(e.ID, 10)]
+		out = append(out, e)
+	}
+	return out
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NOTE: skip_read_after_apply trusts the write payload instead of reading the
+// teammate (GET /v3/teammates/{username} plus every subuser_access page) after
+// each create and update. The computed attributes the read would fill are
+// derived from the plan and prior state instead; the next refresh replaces
+// them with what SendGrid actually stored.
+
+// stateWithoutRead fills the attributes of plan that are still unknown after
+// a write, without calling the API. prior is the state before an update, or
+// nil on create. active reports that create waited for the teammate to become
+// active; subuserAccessChanged that the write changed subuser access.
+func stateWithoutRead(ctx context.Context, plan *ssoTeammateModel, prior *ssoTeammateModel, active, subuserAccessChanged bool, diags *diag.Diagnostics) {
+	plan.ID = types.StringValue(plan.Email.ValueString())
+	if plan.IsAdmin.IsUnknown() {
+		plan.IsAdmin = types.BoolValue(false)
+	}
+	if plan.Scopes.IsUnknown() {
+		plan.Scopes = scopesSliceToSet(nil)
+	}
+
+	switch {
+	case active:
+		plan.Status = types.StringValue("active")
+	case prior != nil:
+		plan.Status = prior.Status
+	default:
+		// The next refresh replaces this with the status SendGrid reports.
+		plan.Status = types.StringValue("pending")
+	}
+
+	if plan.SubuserAccess.IsUnknown() {
+		plan.SubuserAccess = types.SetNull(subuserAccessObjectType())
+	} else if !plan.SubuserAccess.IsNull() {
+		var objs []subuserAccessObject
+		diags.Append(plan.SubuserAccess.ElementsAs(ctx, &objs, false)...)
+		if diags.HasError() {
+			return
+		}
+		for i := range objs {
+			// Usernames of subusers unknown at plan time are only known
+			// to the API.
+			if objs[i].Username.IsUnknown() {
+				objs[i].Username = types.StringNull()
+			}
+			if objs[i].Scopes.IsUnknown() {
+				objs[i].Scopes = types.SetNull(types.StringType)
+			}
+		}
+		set, d := types.SetValueFrom(ctx, subuserAccessObjectType(), objs)
+		diags.Append(d...)
+		plan.SubuserAccess = set
+	}
+
+	// The details come from the API only; they stay valid while subuser
+	// access is unchanged and are otherwise left null until the next refresh.
+	if prior != nil && !subuserAccessChanged {
+		plan.SubuserAccessDetails = prior.SubuserAccessDetails
+	} else {
+		plan.SubuserAccessDetails = types.MapNull(subuserAccessDetailsObjectType())
+	}
+}
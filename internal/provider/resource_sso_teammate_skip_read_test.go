@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestStateWithoutRead(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+	access, d := types.SetValueFrom(ctx, subuserAccessObjectType(), []subuserAccessObject{
+		{ID: types.StringValue("1"), Username: types.StringUnknown(), PermissionType: types.StringValue("admin"), Role: types.StringNull(), Scopes: types.SetUnknown(types.StringType)},
+	})
+	diags.Append(d...)
+	plan := ssoTeammateModel{
+		Email:         types.StringValue("someone@example.com"),
+		IsAdmin:       types.BoolUnknown(),
+		Scopes:        types.SetUnknown(types.StringType),
+		HasRestricted: types.BoolValue(true),
+		SubuserAccess: access,
+		Status:        types.StringUnknown(),
+	}
+
+	stateWithoutRead(ctx, &plan, nil, false, true, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if plan.ID.ValueString() != "someone@example.com" || plan.IsAdmin.ValueBool() || plan.Status.ValueString() != "pending" {
+		t.Fatalf("unexpected state: %+v", plan)
+	}
+	if plan.Scopes.IsUnknown() || !plan.SubuserAccessDetails.IsNull() {
+		t.Fatalf("computed attributes must be known: %+v", plan)
+	}
+	var objs []subuserAccessObject
+	diags.Append(plan.SubuserAccess.ElementsAs(ctx, &objs, false)...)
+	if len(objs) != 1 || !objs[0].Username.IsNull() || !objs[0].Scopes.IsNull() {
+		t.Fatalf("unexpected subuser_access: %+v", objs)
+	}
+
+	// On update, status and unchanged details come from prior state.
+	prior := ssoTeammateModel{
+		Status:               types.StringValue("active"),
+		SubuserAccessDetails: subuserAccessDetailsToMap(ctx, []subuserAccessEntry{{ID: 1, Username: "alpha"}}, &diags),
+	}
+	plan.Status = types.StringUnknown()
+	plan.SubuserAccessDetails = types.MapUnknown(subuserAccessDetailsObjectType())
+	stateWithoutRead(ctx, &plan, &prior, false, false, &diags)
+	if plan.Status.ValueString() != "active" || !plan.SubuserAccessDetails.Equal(prior.SubuserAccessDetails) {
+		t.Fatalf("unexpected state: %+v", plan)
+	}
+}
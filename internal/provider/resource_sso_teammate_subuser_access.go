@@ -0,0 +1,393 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sendgrid/sendgrid-go"
+)
+
+// NOTE: This resource manages one (teammate, subuser) grant, so grants can be
+// owned by different modules instead of one subuser_access block on
+// sendgrid_sso_teammate.
+//
+// API Endpoints:
+//   - Read:                  GET   /v3/teammates/{username}/subuser_access (paginated)
+//   - Create/Update/Delete:  PATCH /v3/sso/teammates/{username}
+//
+// API Documentation:
+//   - Edit SSO Teammate:       https://www.twilio.com/docs/sendgrid/api-reference/single-sign-on-teammates/edit-an-sso-teammate
+//   - Teammate Subuser Access: https://www.twilio.com/docs/sendgrid/api-reference/teammates/retrieve-teammate-subuser-access
+//
+// The API only accepts the teammate's whole subuser access, so every write
+// reads the current entries, replaces or removes this resource's entry, and
+// PATCHes the result. Writes for the same teammate are serialised within a
+// run (see lockTeammate) so parallel grants do not overwrite each other.
+
+var _ resource.Resource = (*SSOTeammateSubuserAccessResource)(nil)
+var _ resource.ResourceWithConfigure = (*SSOTeammateSubuserAccessResource)(nil)
+var _ resource.ResourceWithImportState = (*SSOTeammateSubuserAccessResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*SSOTeammateSubuserAccessResource)(nil)
+
+func NewSSOTeammateSubuserAccessResource() resource.Resource {
+	return &SSOTeammateSubuserAccessResource{}
+}
+
+type SSOTeammateSubuserAccessResource struct{ client *Client }
+
+type ssoTeammateSubuserAccessModel struct {
+	ID              types.String `tfsdk:"id"`
+	Username        types.String `tfsdk:"username"`
+	SubuserID       types.String `tfsdk:"subuser_id"`
+	PermissionType  types.String `tfsdk:"permission_type"`
+	Scopes          types.Set    `tfsdk:"scopes"`
+	SubuserUsername types.String `tfsdk:"subuser_username"`
+}
+
+func (r *SSOTeammateSubuserAccessResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sso_teammate_subuser_access"
+}
+
+func (r *SSOTeammateSubuserAccessResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pc, ok := req.ProviderData.(*Client)
+	if !ok || pc == nil {
+		resp.Diagnostics.AddError("Unexpected ProviderData",
+			"Expected *Client, got something else")
+		return
+	}
+	r.client = pc
+}
+
+func (r *SSOTeammateSubuserAccessResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Grant an SSO teammate access to one subuser. Each grant is its own resource, so different modules can own the grants of the same teammate; the resource only changes its own entry of the teammate's subuser access and removes it on destroy. " +
+			"Manage the teammate with `sendgrid_sso_teammate` and `external_subuser_access = true`, without `subuser_access` blocks.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "`<username>/<subuser_id>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Teammate username (the email of an SSO teammate). Changing it forces replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subuser_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the subuser the teammate gets access to. Changing it forces replacement.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9]+$`), "must be a numeric subuser ID"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permission_type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "`restricted` or `admin`. When `restricted`, only `scopes` are granted.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("restricted", "admin"),
+				},
+			},
+			"scopes": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Scopes granted on the subuser. Required for `restricted`; must be omitted for `admin`.",
+				Validators: []validator.Set{
+					scopeSetValidator{},
+				},
+			},
+			"subuser_username": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Username of the subuser, as reported by SendGrid.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig mirrors the subuser_access checks of sendgrid_sso_teammate.
+func (r *SSOTeammateSubuserAccessResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg ssoTeammateSubuserAccessModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() || cfg.PermissionType.IsUnknown() || cfg.Scopes.IsUnknown() {
+		return
+	}
+	hasScopes := !cfg.Scopes.IsNull() && len(cfg.Scopes.Elements()) > 0
+	switch cfg.PermissionType.ValueString() {
+	case "admin":
+		if hasScopes {
+			resp.Diagnostics.AddAttributeError(path.Root("scopes"), "Invalid attribute combination",
+				"scopes must be empty when permission_type = \"admin\"; admin access already includes every scope.")
+		}
+	case "restricted":
+		if !hasScopes {
+			resp.Diagnostics.AddAttributeError(path.Root("scopes"), "Missing scopes",
+				"permission_type = \"restricted\" requires at least one scope.")
+		}
+	}
+}
+
+// Create adds the grant to the teammate's subuser access.
+func (r *SSOTeammateSubuserAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ssoTeammateSubuserAccessModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.write(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the grant, and removes the resource when the teammate or the
+// grant is gone.
+func (r *SSOTeammateSubuserAccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	var state ssoTeammateSubuserAccessModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entry, found, diags := r.client.teammateSubuserAccessEntry(ctx, state.Username.ValueString(), state.SubuserID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.fromEntry(ctx, entry)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update replaces the grant's permission type and scopes.
+func (r *SSOTeammateSubuserAccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ssoTeammateSubuserAccessModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.write(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the grant, leaving the teammate's other subuser access as is.
+func (r *SSOTeammateSubuserAccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	resp.Diagnostics.Append(r.client.requireScopes(ctx, "remove teammate subuser access", "teammates.update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state ssoTeammateSubuserAccessModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := state.Username.ValueString()
+	_, found, diags := r.client.readTeammate(ctx, username)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !found {
+		return
+	}
+	subuserID, err := strconv.ParseInt(state.SubuserID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid subuser ID", fmt.Sprintf("subuser_id must be a valid integer: %v", err))
+		return
+	}
+	resp.Diagnostics.Append(r.client.editTeammateSubuserAccess(ctx, username, func(entries []subuserAccessEntry) []subuserAccessEntry {
+		return withoutSubuserAccessEntry(entries, subuserID)
+	})...)
+}
+
+// ImportState allows `terraform import sendgrid_sso_teammate_subuser_access.example <username>/<subuser_id>`.
+func (r *SSOTeammateSubuserAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	username, subuserID, ok := strings.Cut(req.ID, "/")
+	if !ok || username == "" || subuserID == "" {
+		resp.Diagnostics.AddError("Invalid import ID",
+			fmt.Sprintf("expected <username>/<subuser_id>, got %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), username)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subuser_id"), subuserID)...)
+}
+
+// write upserts the grant of m and refreshes m from the result.
+func (r *SSOTeammateSubuserAccessResource) write(ctx context.Context, m *ssoTeammateSubuserAccessModel, diags *diag.Diagnostics) {
+	if r.client == nil {
+		diags.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	diags.Append(r.client.requireScopes(ctx, "update teammate subuser access", "teammates.update")...)
+	if diags.HasError() {
+		return
+	}
+
+	entry, ok := m.entry(ctx, diags)
+	if !ok {
+		return
+	}
+	username := m.Username.ValueString()
+	diags.Append(r.client.editTeammateSubuserAccess(ctx, username, func(entries []subuserAccessEntry) []subuserAccessEntry {
+		return append(withoutSubuserAccessEntry(entries, entry.ID), entry)
+	})...)
+	if diags.HasError() {
+		return
+	}
+
+	got, found, readDiags := r.client.teammateSubuserAccessEntry(ctx, username, m.SubuserID.ValueString())
+	diags.Append(readDiags...)
+	if diags.HasError() {
+		return
+	}
+	if !found {
+		diags.AddError("Post-update read failed",
+			fmt.Sprintf("subuser %s was not found in the subuser access of teammate %q after update", m.SubuserID.ValueString(), username))
+		return
+	}
+	m.fromEntry(ctx, got)
+}
+
+// entry converts m into the API entry.
+func (m ssoTeammateSubuserAccessModel) entry(ctx context.Context, diags *diag.Diagnostics) (subuserAccessEntry, bool) {
+	id, err := strconv.ParseInt(m.SubuserID.ValueString(), 10, 64)
+	if err != nil {
+		diags.AddAttributeError(path.Root("subuser_id"), "Invalid subuser ID",
+			fmt.Sprintf("subuser_id must be a valid integer: %v", err))
+		return subuserAccessEntry{}, false
+	}
+	e := subuserAccessEntry{ID: id, PermissionType: m.PermissionType.ValueString()}
+	if !m.Scopes.IsNull() && !m.Scopes.IsUnknown() {
+		diags.Append(m.Scopes.ElementsAs(ctx, &e.Scopes, false)...)
+	}
+	return e, !diags.HasError()
+}
+
+// fromEntry records the remote entry in m. Scopes SendGrid adds on its own are
+// dropped unless m lists them, as for sendgrid_sso_teammate.
+func (m *ssoTeammateSubuserAccessModel) fromEntry(ctx context.Context, e subuserAccessEntry) {
+	m.ID = types.StringValue(m.Username.ValueString() + "/" + m.SubuserID.ValueString())
+	m.PermissionType = types.StringValue(e.PermissionType)
+	m.SubuserUsername = stringOrNull(e.Username)
+	if e.PermissionType == "admin" || len(e.Scopes) == 0 {
+		m.Scopes = types.SetNull(types.StringType)
+		return
+	}
+	m.Scopes = scopesSliceToSet(dropImpliedScopes(ctx, e.Scopes, m.Scopes))
+}
+
+// withoutSubuserAccessEntry returns entries without the entry of subuserID.
+func withoutSubuserAccessEntry(entries []subuserAccessEntry, subuserID int64) []subuserAccessEntry {
+	out := make([]subuserAccessEntry, 0, len(entries))
+	for _, e := range entries {
+		if e.ID != subuserID {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// teammateSubuserAccessEntry returns the subuser access entry of subuserID.
+// found is false when the teammate or the entry does not exist.
+func (c *Client) teammateSubuserAccessEntry(ctx context.Context, username, subuserID string) (subuserAccessEntry, bool, diag.Diagnostics) {
+	_, found, diags := c.readTeammate(ctx, username)
+	if diags.HasError() || !found {
+		return subuserAccessEntry{}, false, diags
+	}
+	_, entries, saDiags := c.teammateSubuserAccess(ctx, username, "", maxSubuserAccessPageSize)
+	diags.Append(saDiags...)
+	if diags.HasError() {
+		return subuserAccessEntry{}, false, diags
+	}
+	for _, e := range entries {
+		if strconv.FormatInt(e.ID, 10) == subuserID {
+			return e, true, diags
+		}
+	}
+	return subuserAccessEntry{}, false, diags
+}
+
+// lockTeammate serialises subuser access edits of one teammate within a run
+// and returns the unlock function.
+func (c *Client) lockTeammate(username string) func() {
+	mu, _ := c.teammateLocks.LoadOrStore(username, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	return mu.(*sync.Mutex).Unlock
+}
+
+// editTeammateSubuserAccess reads every subuser access entry of the teammate,
+// applies edit, and PATCHes the result with has_restricted_subuser_access.
+// PATCH /v3/sso/teammates/{username}
+func (c *Client) editTeammateSubuserAccess(ctx context.Context, username string, edit func([]subuserAccessEntry) []subuserAccessEntry) diag.Diagnostics {
+	unlock := c.lockTeammate(username)
+	defer unlock()
+
+	_, entries, diags := c.teammateSubuserAccess(ctx, username, "", maxSubuserAccessPageSize)
+	if diags.HasError() {
+		return diags
+	}
+	entries = edit(entries)
+
+	// ssoPatchPayload omits an empty subuser_access, which would keep the
+	// last entry instead of removing it, so the body is spelled out.
+	b, _ := json.Marshal(map[string]any{
+		"has_restricted_subuser_access": true,
+		"subuser_access":                entries,
+	})
+	tflog.Debug(ctx, "PATCH /v3/sso/teammates/{username}", map[string]any{"username": username, "entries": len(entries)})
+	reqSG := sendgrid.GetRequest(c.APIKey, "/v3/sso/teammates/"+username, c.BaseURL)
+	reqSG.Method = "PATCH"
+	reqSG.Body = b
+	sgResp, err := sendgrid.API(reqSG)
+	c.invalidateGetCache()
+	if err != nil {
+		diags.AddError("SendGrid API error", err.Error())
+		return diags
+	}
+	diags.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+	if sgResp.StatusCode >= 300 {
+		diags.AddError("Update teammate subuser access failed",
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+	}
+	return diags
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// fakeTeammateSubuserAccess serves one teammate whose subuser access is
+// replaced by every PATCH.
+type fakeTeammateSubuserAccess struct {
+	mu      sync.Mutex
+	entries []map[string]any
+	patches int
+}
+
+func (f *fakeTeammateSubuserAccess) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/v3/teammates/alice@example.com":
+		_, _ = w.Write([]byte(`{"username":"alice@example.com","email":"alice@example.com"}`))
+	case r.Method == http.MethodGet && r.URL.Path == "/v3/teammates/alice@example.com/subuser_access":
+		_ = json.NewEncoder(w).Encode(map[string]any{"has_restricted_subuser_access": true, "subuser_access": f.entries})
+	case r.Method == http.MethodPatch && r.URL.Path == "/v3/sso/teammates/alice@example.com":
+		var body struct {
+			HasRestricted bool             `json:"has_restricted_subuser_access"`
+			SubuserAccess []map[string]any `json:"subuser_access"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if !body.HasRestricted || body.SubuserAccess == nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		for _, e := range body.SubuserAccess {
+			e["username"] = fmt.Sprintf("sub-%v", e["id"])
+		}
+		f.entries = body.SubuserAccess
+		f.patches++
+		_, _ = w.Write([]byte(`{}`))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestEditTeammateSubuserAccess_KeepsOtherEntries(t *testing.T) {
+	fake := &fakeTeammateSubuserAccess{entries: []map[string]any{
+		{"id": 1, "username": "sub-1", "permission_type": "admin"},
+	}}
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+
+	ctx := context.Background()
+	r := &SSOTeammateSubuserAccessResource{client: &Client{BaseURL: srv.URL, APIKey: "test-key", SkipScopePreflight: true}}
+	m := ssoTeammateSubuserAccessModel{
+		Username:       types.StringValue("alice@example.com"),
+		SubuserID:      types.StringValue("2"),
+		PermissionType: types.StringValue("restricted"),
+		Scopes:         scopesSliceToSet([]string{"stats.read"}),
+	}
+	var diags diag.Diagnostics
+	r.write(ctx, &m, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if len(fake.entries) != 2 {
+		t.Fatalf("expected the existing grant to be kept, got %v", fake.entries)
+	}
+	if m.ID.ValueString() != "alice@example.com/2" || m.SubuserUsername.ValueString() != "sub-2" {
+		t.Fatalf("unexpected model after write: %+v", m)
+	}
+
+	if d := r.client.editTeammateSubuserAccess(ctx, "alice@example.com", func(e []subuserAccessEntry) []subuserAccessEntry {
+		return withoutSubuserAccessEntry(e, 2)
+	}); d.HasError() {
+		t.Fatalf("unexpected diags: %v", d)
+	}
+	if len(fake.entries) != 1 || fake.entries[0]["permission_type"] != "admin" {
+		t.Fatalf("expected only the other grant to remain, got %v", fake.entries)
+	}
+
+	// Removing the last grant still sends an (empty) subuser_access.
+	if d := r.client.editTeammateSubuserAccess(ctx, "alice@example.com", func(e []subuserAccessEntry) []subuserAccessEntry {
+		return withoutSubuserAccessEntry(e, 1)
+	}); d.HasError() {
+		t.Fatalf("unexpected diags: %v", d)
+	}
+	if len(fake.entries) != 0 || fake.patches != 3 {
+		t.Fatalf("expected no grants after 3 PATCHes, got %v after %d", fake.entries, fake.patches)
+	}
+}
+
+func TestSSOTeammateSubuserAccessModel_FromEntryDropsImpliedScopes(t *testing.T) {
+	m := ssoTeammateSubuserAccessModel{
+		Username:  types.StringValue("alice@example.com"),
+		SubuserID: types.StringValue("2"),
+		Scopes:    scopesSliceToSet([]string{"templates.create"}),
+	}
+	m.fromEntry(context.Background(), subuserAccessEntry{ID: 2, PermissionType: "restricted", Scopes: []string{"templates.create", "templates.read"}, Username: "sub-2"})
+	var scopes []string
+	m.Scopes.ElementsAs(context.Background(), &scopes, false)
+	if len(scopes) != 1 || scopes[0] != "templates.create" {
+		t.Fatalf("scopes = %v", scopes)
+	}
+
+	m.fromEntry(context.Background(), subuserAccessEntry{ID: 2, PermissionType: "admin"})
+	if !m.Scopes.IsNull() || m.PermissionType.ValueString() != "admin" {
+		t.Fatalf("unexpected admin model: %+v", m)
+	}
+}
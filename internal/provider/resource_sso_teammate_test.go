@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	prov "github.com/diamond-cto/terraform-provider-sendgrid/internal/provider"
+	"github.com/diamond-cto/terraform-provider-sendgrid/internal/testacc"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -220,10 +221,7 @@ func TestAccResourceSSOTeammate_CRUD_Import(t *testing.T) {
 
 	rSuffix := acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
 	email := fmt.Sprintf("terraform-acctest-%s@example.com", rSuffix)
-	subID := os.Getenv("TEST_SUBUSER_ID")
-	if email == "" || subID == "" {
-		t.Skip("TEST_SSO_EMAIL or TEST_SUBUSER_ID not set; skipping TestAccResourceSSOTeammate_CRUD_Import")
-	}
+	subID := testacc.Subuser(t).ID
 
 	cfgCreate := buildResourceConfig(email, "Terraform", "AccTest", subID)
 	cfgUpdate := buildResourceConfig(email, "Terraform-Updated", "AccTest", subID)
@@ -295,13 +293,11 @@ func TestAccResourceSSOTeammate_MultipleSubusers(t *testing.T) {
 	rSuffix := acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
 	email := fmt.Sprintf("terraform-acctest-multi-%s@example.com", rSuffix)
 
-	// Get subuser IDs from environment
+	// Use the subusers from the environment, or provision two
 	subID1 := os.Getenv("TEST_SUBUSER_ID_1")
 	subID2 := os.Getenv("TEST_SUBUSER_ID_2")
-
-	// If multiple subuser IDs not set, skip this test
 	if subID1 == "" || subID2 == "" {
-		t.Skip("TEST_SUBUSER_ID_1 and TEST_SUBUSER_ID_2 not set; skipping TestAccResourceSSOTeammate_MultipleSubusers")
+		subID1, subID2 = testacc.NewSubuser(t).ID, testacc.NewSubuser(t).ID
 	}
 
 	subuserIDs := []string{subID1, subID2}
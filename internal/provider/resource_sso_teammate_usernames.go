@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NOTE: subuser_access entries may be keyed by `id` or by subuser `username`.
+// Both are stored; whichever is missing from the configuration is resolved at
+// plan time, from prior state when possible and otherwise from GET /v3/subusers
+// (cached between writes, see Client.allSubusers).
+
+// resolveSubuserAccessKeys fills in the missing id or username of every
+// planned subuser_access entry. Entries whose id is not known to the account
+// keep an unknown username, which is filled from the API after apply.
+func (r *SSOTeammateResource) resolveSubuserAccessKeys(ctx context.Context, planned, prior types.Set, diags *diag.Diagnostics) types.Set {
+	if planned.IsNull() || planned.IsUnknown() {
+		return planned
+	}
+	var objs []subuserAccessObject
+	diags.Append(planned.ElementsAs(ctx, &objs, false)...)
+	if diags.HasError() {
+		return planned
+	}
+
+	priorNames := map[string]string{}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var priorObjs []subuserAccessObject
+		if d := prior.ElementsAs(ctx, &priorObjs, false); !d.HasError() {
+			for _, o := range priorObjs {
+				if !o.ID.IsNull() && !o.Username.IsNull() && !o.Username.IsUnknown() {
+					priorNames[o.ID.ValueString()] = o.Username.ValueString()
+				}
+			}
+		}
+	}
+
+	var byID, byName map[string]string
+	lookup := func() bool {
+		if byID != nil {
+			return true
+		}
+		subusers, listDiags := r.client.allSubusers(ctx)
+		diags.Append(listDiags...)
+		if diags.HasError() {
+			return false
+		}
+		byID = make(map[string]string, len(subusers))
+		byName = make(map[string]string, len(subusers))
+		for _, su := range subusers {
+			id := strconv.FormatInt(su.ID, 10)
+			byID[id] = su.Username
+			byName[su.Username] = id
+		}
+		return true
+	}
+
+	for i, o := range objs {
+		switch {
+		case o.ID.IsUnknown() || (o.ID.IsNull() && o.Username.IsUnknown()):
+			continue
+		case o.ID.IsNull() && o.Username.IsNull():
+			// Rejected by ConfigValidators.
+			continue
+		case o.ID.IsNull():
+			if !lookup() {
+				return planned
+			}
+			id, ok := byName[o.Username.ValueString()]
+			if !ok {
+				diags.AddAttributeError(path.Root("subuser_access"), "Unknown subuser",
+					fmt.Sprintf("No subuser with username %q exists in this account (GET /v3/subusers).", o.Username.ValueString()))
+				continue
+			}
+			objs[i].ID = types.StringValue(id)
+		case o.Username.IsNull() || o.Username.IsUnknown():
+			if name, ok := priorNames[o.ID.ValueString()]; ok {
+				objs[i].Username = types.StringValue(name)
+				continue
+			}
+			if !lookup() {
+				return planned
+			}
+			if name, ok := byID[o.ID.ValueString()]; ok {
+				objs[i].Username = types.StringValue(name)
+			} else {
+				objs[i].Username = types.StringUnknown()
+			}
+		}
+	}
+	if diags.HasError() {
+		return planned
+	}
+
+	out, d := types.SetValueFrom(ctx, subuserAccessObjectType(), objs)
+	diags.Append(d...)
+	return out
+}
@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// ConfigValidators rejects attribute combinations the SSO Teammate API does
+// not accept, at plan time instead of as an opaque 400 at apply.
+func (r *SSOTeammateResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		ssoTeammateConfigValidator{
+			description: "is_admin = true cannot be combined with persona, has_restricted_subuser_access = true, or subuser_access",
+			validate:    validateSSOTeammateAdmin,
+		},
+		ssoTeammateConfigValidator{
+			description: "persona cannot be combined with has_restricted_subuser_access = true",
+			validate:    validateSSOTeammatePersona,
+		},
+		ssoTeammateConfigValidator{
+			description: "scopes cannot be combined with has_restricted_subuser_access = true or subuser_access",
+			validate:    validateSSOTeammateScopes,
+		},
+		ssoTeammateConfigValidator{
+			description: "subuser_access entries need id or username and require has_restricted_subuser_access = true; admin entries take no scopes and restricted entries need at least one",
+			validate:    validateSSOTeammateSubuserAccess,
+		},
+		ssoTeammateConfigValidator{
+			description: "all_subusers requires permission_type and has_restricted_subuser_access = true, and cannot be combined with subuser_access, scopes, persona, or is_admin = true",
+			validate:    validateSSOTeammateAllSubusers,
+		},
+		ssoTeammateConfigValidator{
+			description: "subuser_access.role requires permission_type = restricted",
+			validate:    validateSSOTeammateRoles,
+		},
+		ssoTeammateConfigValidator{
+			description: "external_subuser_access requires has_restricted_subuser_access = true and cannot be combined with subuser_access or all_subusers",
+			validate:    validateSSOTeammateExternalSubuserAccess,
+		},
+	}
+}
+
+// ssoTeammateConfigValidator adapts a function over the decoded configuration
+// to resource.ConfigValidator.
+type ssoTeammateConfigValidator struct {
+	description string
+	validate    func(ctx context.Context, cfg ssoTeammateModel, diags *diag.Diagnostics)
+}
+
+var _ resource.ConfigValidator = ssoTeammateConfigValidator{}
+
+func (v ssoTeammateConfigValidator) Description(_ context.Context) string { return v.description }
+
+func (v ssoTeammateConfigValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ssoTeammateConfigValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg ssoTeammateModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	v.validate(ctx, cfg, &resp.Diagnostics)
+}
+
+func validateSSOTeammateAdmin(_ context.Context, cfg ssoTeammateModel, diags *diag.Diagnostics) {
+	if cfg.IsAdmin.IsNull() || cfg.IsAdmin.IsUnknown() || !cfg.IsAdmin.ValueBool() {
+		return
+	}
+	if !cfg.Persona.IsNull() && !cfg.Persona.IsUnknown() {
+		diags.AddAttributeError(path.Root("persona"), "Invalid attribute combination",
+			"persona cannot be set when is_admin = true; admins already hold every main-account scope.")
+	}
+	if !cfg.HasRestricted.IsUnknown() && cfg.HasRestricted.ValueBool() {
+		diags.AddAttributeError(path.Root("has_restricted_subuser_access"), "Invalid attribute combination",
+			"has_restricted_subuser_access must be false when is_admin = true.")
+	}
+	if !cfg.SubuserAccess.IsNull() && !cfg.SubuserAccess.IsUnknown() && len(cfg.SubuserAccess.Elements()) > 0 {
+		diags.AddAttributeError(path.Root("subuser_access"), "Invalid attribute combination",
+			"subuser_access cannot be set when is_admin = true; admins already have access to every subuser.")
+	}
+}
+
+func validateSSOTeammatePersona(_ context.Context, cfg ssoTeammateModel, diags *diag.Diagnostics) {
+	if cfg.Persona.IsNull() || cfg.Persona.IsUnknown() {
+		return
+	}
+	if !cfg.HasRestricted.IsUnknown() && cfg.HasRestricted.ValueBool() {
+		diags.AddAttributeError(path.Root("persona"), "Invalid attribute combination",
+			"persona grants main-account scopes and cannot be combined with has_restricted_subuser_access = true; use subuser_access instead.")
+	}
+}
+
+func validateSSOTeammateScopes(_ context.Context, cfg ssoTeammateModel, diags *diag.Diagnostics) {
+	if cfg.Scopes.IsNull() || cfg.Scopes.IsUnknown() || len(cfg.Scopes.Elements()) == 0 {
+		return
+	}
+	if !cfg.HasRestricted.IsUnknown() && cfg.HasRestricted.ValueBool() {
+		diags.AddAttributeError(path.Root("scopes"), "Invalid attribute combination",
+			"Top-level scopes apply to the main account and cannot be combined with has_restricted_subuser_access = true; grant per-subuser scopes via subuser_access instead.")
+		return
+	}
+	if !cfg.SubuserAccess.IsNull() && !cfg.SubuserAccess.IsUnknown() && len(cfg.SubuserAccess.Elements()) > 0 {
+		diags.AddAttributeError(path.Root("scopes"), "Invalid attribute combination",
+			"Top-level scopes and subuser_access are mutually exclusive.")
+	}
+}
+
+func validateSSOTeammateSubuserAccess(ctx context.Context, cfg ssoTeammateModel, diags *diag.Diagnostics) {
+	entries := configuredSubuserAccess(ctx, cfg.SubuserAccess, diags)
+	if len(entries) == 0 {
+		return
+	}
+	if !cfg.HasRestricted.IsUnknown() && !cfg.HasRestricted.ValueBool() {
+		diags.AddAttributeError(path.Root("subuser_access"), "Invalid attribute combination",
+			"subuser_access is only applied when has_restricted_subuser_access = true.")
+	}
+	for _, e := range entries {
+		if e.Object.ID.IsNull() && e.Object.Username.IsNull() {
+			diags.AddAttributeError(path.Root("subuser_access").AtSetValue(e.Value), "Missing subuser",
+				"Each subuser_access entry must set id or username.")
+		}
+		if e.Object.PermissionType.IsUnknown() || e.Object.Scopes.IsUnknown() {
+			continue
+		}
+		scopesPath := path.Root("subuser_access").AtSetValue(e.Value).AtName("scopes")
+		hasScopes := !e.Object.Scopes.IsNull() && len(e.Object.Scopes.Elements()) > 0
+		switch e.Object.PermissionType.ValueString() {
+		case "admin":
+			if hasScopes {
+				diags.AddAttributeError(scopesPath, "Invalid attribute combination",
+					"scopes must be empty when permission_type = \"admin\"; admin access already includes every scope.")
+			}
+		case "restricted":
+			if !hasScopes && e.Object.Role.IsNull() {
+				diags.AddAttributeError(scopesPath, "Missing scopes",
+					"permission_type = \"restricted\" requires at least one scope (or a role).")
+			}
+		}
+	}
+}
+
+func validateSSOTeammateAllSubusers(ctx context.Context, cfg ssoTeammateModel, diags *diag.Diagnostics) {
+	all, ok := cfg.allSubusers(ctx, diags)
+	if !ok {
+		return
+	}
+	if all.PermissionType.IsNull() {
+		diags.AddAttributeError(path.Root("all_subusers").AtName("permission_type"), "Missing required argument",
+			"permission_type must be set in all_subusers.")
+	}
+	if !cfg.HasRestricted.IsUnknown() && !cfg.HasRestricted.ValueBool() {
+		diags.AddAttributeError(path.Root("all_subusers"), "Invalid attribute combination",
+			"all_subusers requires has_restricted_subuser_access = true.")
+	}
+	if !cfg.IsAdmin.IsUnknown() && cfg.IsAdmin.ValueBool() {
+		diags.AddAttributeError(path.Root("all_subusers"), "Invalid attribute combination",
+			"all_subusers cannot be set when is_admin = true; admins already have access to every subuser.")
+	}
+	if !cfg.SubuserAccess.IsNull() && !cfg.SubuserAccess.IsUnknown() && len(cfg.SubuserAccess.Elements()) > 0 {
+		diags.AddAttributeError(path.Root("all_subusers"), "Invalid attribute combination",
+			"all_subusers and subuser_access are mutually exclusive.")
+	}
+	if !cfg.Scopes.IsNull() && !cfg.Scopes.IsUnknown() && len(cfg.Scopes.Elements()) > 0 {
+		diags.AddAttributeError(path.Root("all_subusers"), "Invalid attribute combination",
+			"all_subusers and top-level scopes are mutually exclusive.")
+	}
+	if !cfg.Persona.IsNull() && !cfg.Persona.IsUnknown() {
+		diags.AddAttributeError(path.Root("all_subusers"), "Invalid attribute combination",
+			"all_subusers cannot be combined with persona.")
+	}
+}
+
+func validateSSOTeammateRoles(ctx context.Context, cfg ssoTeammateModel, diags *diag.Diagnostics) {
+	for _, e := range configuredSubuserAccess(ctx, cfg.SubuserAccess, diags) {
+		if e.Object.Role.IsNull() || e.Object.Role.IsUnknown() || e.Object.PermissionType.IsUnknown() {
+			continue
+		}
+		if e.Object.PermissionType.ValueString() != "restricted" {
+			diags.AddAttributeError(path.Root("subuser_access").AtSetValue(e.Value).AtName("role"), "Invalid attribute combination",
+				"role only applies to permission_type = restricted; admin access already includes every scope.")
+		}
+	}
+}
+
+func validateSSOTeammateExternalSubuserAccess(_ context.Context, cfg ssoTeammateModel, diags *diag.Diagnostics) {
+	if !cfg.ExternalSubuserAccess.ValueBool() {
+		return
+	}
+	if !cfg.HasRestricted.IsUnknown() && !cfg.HasRestricted.ValueBool() {
+		diags.AddAttributeError(path.Root("external_subuser_access"), "Invalid attribute combination",
+			"external_subuser_access requires has_restricted_subuser_access = true.")
+	}
+	if !cfg.SubuserAccess.IsNull() && len(cfg.SubuserAccess.Elements()) > 0 {
+		diags.AddAttributeError(path.Root("subuser_access"), "Invalid attribute combination",
+			"subuser_access cannot be combined with external_subuser_access; grant access with sendgrid_sso_teammate_subuser_access instead.")
+	}
+	if !cfg.AllSubusers.IsNull() {
+		diags.AddAttributeError(path.Root("all_subusers"), "Invalid attribute combination",
+			"all_subusers cannot be combined with external_subuser_access.")
+	}
+}
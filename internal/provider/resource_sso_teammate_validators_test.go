@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSSOTeammateConfigValidators(t *testing.T) {
+	base := func() ssoTeammateModel {
+		return ssoTeammateModel{
+			IsAdmin:       types.BoolNull(),
+			Persona:       types.StringNull(),
+			Scopes:        types.SetNull(types.StringType),
+			HasRestricted: types.BoolValue(false),
+			SubuserAccess: types.SetNull(subuserAccessObjectType()),
+			AllSubusers:   types.ObjectNull(allSubusersObjectType().AttrTypes),
+		}
+	}
+	subuserAccess := func(permissionType string, scopes []string) types.Set {
+		v, _ := types.SetValueFrom(context.Background(), subuserAccessObjectType(), []subuserAccessObject{{
+			ID:             types.StringValue("1"),
+			PermissionType: types.StringValue(permissionType),
+			Role:           types.StringNull(),
+			Scopes:         scopesSliceToSet(scopes),
+		}})
+		return v
+	}
+	allSubusers := func(permissionType string) types.Object {
+		var diags diag.Diagnostics
+		return allSubusersToObject(context.Background(), allSubusersObject{
+			PermissionType: types.StringValue(permissionType),
+			Scopes:         types.SetNull(types.StringType),
+			SubuserIDs:     types.SetUnknown(types.StringType),
+		}, &diags)
+	}
+
+	cases := map[string]struct {
+		mutate   func(m *ssoTeammateModel)
+		validate func(context.Context, ssoTeammateModel, *diag.Diagnostics)
+		wantErr  bool
+	}{
+		"admin alone": {
+			mutate:   func(m *ssoTeammateModel) { m.IsAdmin = types.BoolValue(true) },
+			validate: validateSSOTeammateAdmin,
+		},
+		"admin with restricted access": {
+			mutate: func(m *ssoTeammateModel) {
+				m.IsAdmin = types.BoolValue(true)
+				m.HasRestricted = types.BoolValue(true)
+			},
+			validate: validateSSOTeammateAdmin,
+			wantErr:  true,
+		},
+		"admin with persona": {
+			mutate: func(m *ssoTeammateModel) {
+				m.IsAdmin = types.BoolValue(true)
+				m.Persona = types.StringValue("developer")
+			},
+			validate: validateSSOTeammateAdmin,
+			wantErr:  true,
+		},
+		"persona alone": {
+			mutate:   func(m *ssoTeammateModel) { m.Persona = types.StringValue("observer") },
+			validate: validateSSOTeammatePersona,
+		},
+		"persona with restricted access": {
+			mutate: func(m *ssoTeammateModel) {
+				m.Persona = types.StringValue("observer")
+				m.HasRestricted = types.BoolValue(true)
+			},
+			validate: validateSSOTeammatePersona,
+			wantErr:  true,
+		},
+		"scopes alone": {
+			mutate:   func(m *ssoTeammateModel) { m.Scopes = scopesSliceToSet([]string{"stats.read"}) },
+			validate: validateSSOTeammateScopes,
+		},
+		"scopes with restricted access": {
+			mutate: func(m *ssoTeammateModel) {
+				m.Scopes = scopesSliceToSet([]string{"stats.read"})
+				m.HasRestricted = types.BoolValue(true)
+			},
+			validate: validateSSOTeammateScopes,
+			wantErr:  true,
+		},
+		"subuser_access without restricted access": {
+			mutate:   func(m *ssoTeammateModel) { m.SubuserAccess = subuserAccess("admin", nil) },
+			validate: validateSSOTeammateSubuserAccess,
+			wantErr:  true,
+		},
+		"admin subuser_access with scopes": {
+			mutate: func(m *ssoTeammateModel) {
+				m.HasRestricted = types.BoolValue(true)
+				m.SubuserAccess = subuserAccess("admin", []string{"stats.read"})
+			},
+			validate: validateSSOTeammateSubuserAccess,
+			wantErr:  true,
+		},
+		"restricted subuser_access without scopes": {
+			mutate: func(m *ssoTeammateModel) {
+				m.HasRestricted = types.BoolValue(true)
+				m.SubuserAccess = subuserAccess("restricted", nil)
+			},
+			validate: validateSSOTeammateSubuserAccess,
+			wantErr:  true,
+		},
+		"restricted subuser_access with scopes": {
+			mutate: func(m *ssoTeammateModel) {
+				m.HasRestricted = types.BoolValue(true)
+				m.SubuserAccess = subuserAccess("restricted", []string{"stats.read"})
+			},
+			validate: validateSSOTeammateSubuserAccess,
+		},
+		"all_subusers with restricted access": {
+			mutate: func(m *ssoTeammateModel) {
+				m.AllSubusers = allSubusers("admin")
+				m.HasRestricted = types.BoolValue(true)
+			},
+			validate: validateSSOTeammateAllSubusers,
+		},
+		"all_subusers without restricted access": {
+			mutate:   func(m *ssoTeammateModel) { m.AllSubusers = allSubusers("admin") },
+			validate: validateSSOTeammateAllSubusers,
+			wantErr:  true,
+		},
+		"external subuser access": {
+			mutate: func(m *ssoTeammateModel) {
+				m.ExternalSubuserAccess = types.BoolValue(true)
+				m.HasRestricted = types.BoolValue(true)
+			},
+			validate: validateSSOTeammateExternalSubuserAccess,
+		},
+		"external subuser access without restricted access": {
+			mutate:   func(m *ssoTeammateModel) { m.ExternalSubuserAccess = types.BoolValue(true) },
+			validate: validateSSOTeammateExternalSubuserAccess,
+			wantErr:  true,
+		},
+		"external subuser access with subuser_access": {
+			mutate: func(m *ssoTeammateModel) {
+				m.ExternalSubuserAccess = types.BoolValue(true)
+				m.HasRestricted = types.BoolValue(true)
+				m.SubuserAccess = subuserAccess("restricted", []string{"stats.read"})
+			},
+			validate: validateSSOTeammateExternalSubuserAccess,
+			wantErr:  true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := base()
+			tc.mutate(&m)
+			var diags diag.Diagnostics
+			tc.validate(context.Background(), m, &diags)
+			if diags.HasError() != tc.wantErr {
+				t.Fatalf("HasError() = %v, want %v: %v", diags.HasError(), tc.wantErr, diags)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -42,6 +44,7 @@ import (
 var _ resource.Resource = (*SubuserResource)(nil)
 var _ resource.ResourceWithConfigure = (*SubuserResource)(nil)
 var _ resource.ResourceWithImportState = (*SubuserResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*SubuserResource)(nil)
 
 func NewSubuserResource() resource.Resource { return &SubuserResource{} }
 
@@ -124,11 +127,22 @@ func (r *SubuserResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 			"disabled": schema.BoolAttribute{
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "Whether the subuser is disabled. Can be toggled after creation via PATCH.",
+				MarkdownDescription: "Whether the subuser is disabled. Toggled in place via PATCH, so a tenant can be suspended and reinstated without losing its configuration, history, or sender reputation. Setting it at creation disables the new subuser right away.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"region": schema.StringAttribute{
+				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "Region string returned by the API (may be empty).",
+				MarkdownDescription: "Region the subuser is created in: `global` or `eu`. EU subusers must be managed through the EU API, so the provider `base_url` must be `https://api.eu.sendgrid.com`. When omitted, the API default is used and the returned region is recorded. Changing this forces replacement.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("global", "eu"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 		},
 	}
@@ -137,10 +151,12 @@ func (r *SubuserResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 // ---------- API payloads ----------
 
 type subuserCreatePayload struct {
-	Username string   `json:"username"`
-	Email    string   `json:"email"`
-	Password string   `json:"password"`
-	IPs      []string `json:"ips"`
+	Username      string   `json:"username"`
+	Email         string   `json:"email"`
+	Password      string   `json:"password"`
+	IPs           []string `json:"ips"`
+	Region        string   `json:"region,omitempty"`
+	IncludeRegion bool     `json:"include_region,omitempty"`
 }
 
 // subuserCreateResponse is the body returned by POST /v3/subusers.
@@ -165,6 +181,10 @@ func (r *SubuserResource) Create(ctx context.Context, req resource.CreateRequest
 		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
 		return
 	}
+	resp.Diagnostics.Append(r.client.requireScopes(ctx, "create subuser", "subusers.create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	var plan subuserModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -184,6 +204,10 @@ func (r *SubuserResource) Create(ctx context.Context, req resource.CreateRequest
 		Password: plan.Password.ValueString(),
 		IPs:      ips,
 	}
+	if !plan.Region.IsNull() && !plan.Region.IsUnknown() {
+		payload.Region = plan.Region.ValueString()
+		payload.IncludeRegion = true
+	}
 
 	b, _ := json.Marshal(payload)
 	reqSG := sendgrid.GetRequest(r.client.APIKey, "/v3/subusers", r.client.BaseURL)
@@ -191,10 +215,12 @@ func (r *SubuserResource) Create(ctx context.Context, req resource.CreateRequest
 	reqSG.Body = b
 
 	sgResp, err := sendgrid.API(reqSG)
+	r.client.invalidateGetCache()
 	if err != nil {
 		resp.Diagnostics.AddError("SendGrid API error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
 	if sgResp.StatusCode >= 300 {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Create Subuser failed: %s", apiErrorMessage(sgResp.Body)),
@@ -210,8 +236,23 @@ func (r *SubuserResource) Create(ctx context.Context, req resource.CreateRequest
 
 	plan.ID = types.StringValue(strconv.FormatInt(created.ID, 10))
 
-	// Read back to populate computed attributes (disabled, region).
+	// Subusers are always created enabled; apply disabled = true separately.
 	username := plan.Username.ValueString()
+	if plan.Disabled.ValueBool() {
+		if diags := r.setDisabled(ctx, username, true); diags.HasError() {
+			// The subuser exists but is still enabled; record it so
+			// Terraform taints it instead of losing track of it.
+			plan.Disabled = types.BoolValue(false)
+			if plan.Region.IsUnknown() {
+				plan.Region = types.StringNull()
+			}
+			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	// Read back to populate computed attributes (disabled, region).
 	got, found, diags := r.readSubuser(ctx, username)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -280,6 +321,10 @@ func (r *SubuserResource) Update(ctx context.Context, req resource.UpdateRequest
 		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
 		return
 	}
+	resp.Diagnostics.Append(r.client.requireScopes(ctx, "update subuser", "subusers.update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	var plan subuserModel
 	var state subuserModel
@@ -292,20 +337,9 @@ func (r *SubuserResource) Update(ctx context.Context, req resource.UpdateRequest
 	username := state.Username.ValueString()
 
 	// disabled 以外の変更可能属性は RequiresReplace 指定のため、ここでは disabled のみ扱う。
-	if !plan.Disabled.Equal(state.Disabled) {
-		payload := subuserPatchPayload{Disabled: plan.Disabled.ValueBool()}
-		b, _ := json.Marshal(payload)
-		reqSG := sendgrid.GetRequest(r.client.APIKey, "/v3/subusers/"+username, r.client.BaseURL)
-		reqSG.Method = "PATCH"
-		reqSG.Body = b
-		sgResp, err := sendgrid.API(reqSG)
-		if err != nil {
-			resp.Diagnostics.AddError("SendGrid API error", err.Error())
-			return
-		}
-		if sgResp.StatusCode >= 300 {
-			resp.Diagnostics.AddError("Update Subuser failed",
-				fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+	if !plan.Disabled.IsUnknown() && !plan.Disabled.Equal(state.Disabled) {
+		resp.Diagnostics.Append(r.setDisabled(ctx, username, plan.Disabled.ValueBool())...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
 	}
@@ -338,6 +372,10 @@ func (r *SubuserResource) Delete(ctx context.Context, req resource.DeleteRequest
 		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
 		return
 	}
+	resp.Diagnostics.Append(r.client.requireScopes(ctx, "delete subuser", "subusers.delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	var state subuserModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -345,13 +383,15 @@ func (r *SubuserResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 
 	username := state.Username.ValueString()
-	reqSG := sendgrid.GetRequest(r.client.APIKey, "/v3/subusers/"+username, r.client.BaseURL)
+	reqSG := sendgrid.GetRequest(r.client.APIKey, "/v3/subusers/"+url.PathEscape(username), r.client.BaseURL)
 	reqSG.Method = "DELETE"
 	sgResp, err := sendgrid.API(reqSG)
+	r.client.invalidateGetCache()
 	if err != nil {
 		resp.Diagnostics.AddError("SendGrid API error", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
 	if sgResp.StatusCode >= 300 && sgResp.StatusCode != 404 {
 		resp.Diagnostics.AddError("Delete Subuser failed",
 			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
@@ -359,6 +399,26 @@ func (r *SubuserResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 }
 
+// ModifyPlan rejects a region that does not match the provider base URL, since
+// EU subusers can only be created and managed through the EU API host.
+func (r *SubuserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+	var region types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("region"), &region)...)
+	if resp.Diagnostics.HasError() || region.IsNull() || region.IsUnknown() {
+		return
+	}
+	hostRegion, ok := baseURLRegion(r.client.BaseURL)
+	if !ok || hostRegion == region.ValueString() {
+		return
+	}
+	resp.Diagnostics.AddAttributeError(path.Root("region"), "Region does not match base_url",
+		fmt.Sprintf("region = %q requires the provider base_url to be %s, but it is %s.",
+			region.ValueString(), regionBaseURLs[region.ValueString()], r.client.BaseURL))
+}
+
 // ImportState allows `terraform import sendgrid_subuser.example <username>`.
 func (r *SubuserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("username"), req, resp)
@@ -369,21 +429,17 @@ func (r *SubuserResource) ImportState(ctx context.Context, req resource.ImportSt
 func (r *SubuserResource) readSubuser(ctx context.Context, username string) (subuserAPI, bool, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	reqSG := sendgrid.GetRequest(r.client.APIKey, "/v3/subusers", r.client.BaseURL)
-	reqSG.Method = "GET"
-	if reqSG.QueryParams == nil {
-		reqSG.QueryParams = make(map[string]string)
-	}
-	reqSG.QueryParams["username"] = username
-	reqSG.QueryParams["include_region"] = "true"
-
 	tflog.Debug(ctx, "GET /v3/subusers", map[string]any{"username": username})
 
-	sgResp, err := sendgrid.API(reqSG)
+	sgResp, err := r.client.cachedGet(ctx, "/v3/subusers", map[string]string{
+		"username":       username,
+		"include_region": "true",
+	})
 	if err != nil {
 		diags.AddError("SendGrid API error", err.Error())
 		return subuserAPI{}, false, diags
 	}
+	diags.Append(deprecationWarnings("GET", "/v3/subusers", sgResp.Headers)...)
 	if sgResp.StatusCode >= 300 {
 		diags.AddError("Read Subuser failed",
 			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
@@ -406,6 +462,33 @@ func (r *SubuserResource) readSubuser(ctx context.Context, username string) (sub
 	return subuserAPI{}, false, diags
 }
 
+// setDisabled enables or disables a subuser.
+// PATCH /v3/subusers/{username}
+func (r *SubuserResource) setDisabled(ctx context.Context, username string, disabled bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	b, _ := json.Marshal(subuserPatchPayload{Disabled: disabled})
+	reqSG := sendgrid.GetRequest(r.client.APIKey, "/v3/subusers/"+url.PathEscape(username), r.client.BaseURL)
+	reqSG.Method = "PATCH"
+	reqSG.Body = b
+
+	tflog.Debug(ctx, "PATCH /v3/subusers/{username}", map[string]any{"username": username, "disabled": disabled})
+
+	sgResp, err := sendgrid.API(reqSG)
+	r.client.invalidateGetCache()
+	if err != nil {
+		diags.AddError("SendGrid API error", err.Error())
+		return diags
+	}
+	diags.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+	if sgResp.StatusCode >= 300 {
+		diags.AddError(
+			fmt.Sprintf("Update Subuser failed: %s", apiErrorMessage(sgResp.Body)),
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+	}
+	return diags
+}
+
 // regionToStringValue maps an API region string to a Terraform value,
 // returning null when empty.
 func regionToStringValue(region string) types.String {
@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	"github.com/diamond-cto/terraform-provider-sendgrid/internal/testacc"
 )
@@ -59,6 +60,7 @@ func newMockSendGrid(t *testing.T) *httptest.Server {
 				Email    string   `json:"email"`
 				Password string   `json:"password"`
 				IPs      []string `json:"ips"`
+				Region   string   `json:"region"`
 			}
 			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 				writeErr(w, http.StatusBadRequest, "invalid body", "")
@@ -75,12 +77,16 @@ func newMockSendGrid(t *testing.T) *httptest.Server {
 				return
 			}
 			nextID++
+			region := body.Region
+			if region == "" {
+				region = "global"
+			}
 			store[body.Username] = &fakeSubuser{
 				ID:       nextID,
 				Username: body.Username,
 				Email:    body.Email,
 				Disabled: false,
-				Region:   "global",
+				Region:   region,
 			}
 			w.WriteHeader(http.StatusCreated)
 			// Documented Create response shape: user_id (not id), no ips.
@@ -260,3 +266,143 @@ resource "sendgrid_subuser" "bad" {
 		},
 	})
 }
+
+// TestSubuserResource_mock_RegionMismatch verifies that an EU subuser cannot
+// be planned against the global API host.
+func TestSubuserResource_mock_RegionMismatch(t *testing.T) {
+	config := `
+provider "sendgrid" {
+  base_url = "https://api.sendgrid.com"
+  api_key  = "test-key"
+}
+
+resource "sendgrid_subuser" "eu" {
+  username = "acctest-eu.example"
+  email    = "acctest-eu@example.com"
+  password = "abc12345"
+  ips      = ["192.0.2.10"]
+  region   = "eu"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testacc.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`requires the provider base_url to be https://api.eu.sendgrid.com`),
+			},
+		},
+	})
+}
+
+// TestSubuserResource_mock_CreateDisabled verifies that disabled = true at
+// creation results in a disabled subuser rather than an inconsistent result.
+func TestSubuserResource_mock_CreateDisabled(t *testing.T) {
+	srv := newMockSendGrid(t)
+	defer srv.Close()
+
+	config := mockProviderConfig(srv.URL) + `
+resource "sendgrid_subuser" "test" {
+  username = "acctest-paused.example"
+  email    = "acctest-paused@example.com"
+  password = "abc12345"
+  ips      = ["192.0.2.10"]
+  disabled = true
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testacc.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_subuser.test", "disabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+// TestSubuserResource_mock_CreateDisabledPatchFails verifies that a subuser
+// whose disable PATCH fails after creation is still recorded in state, so it is
+// tainted and destroyed instead of being orphaned.
+func TestSubuserResource_mock_CreateDisabledPatchFails(t *testing.T) {
+	var mu sync.Mutex
+	created, deleted := false, false
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v3/subusers", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case http.MethodPost:
+			created = true
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"username": "acctest-orphan.example",
+				"user_id":  25000001,
+				"email":    "acctest-orphan@example.com",
+			})
+		case http.MethodGet:
+			out := []map[string]any{}
+			if created && !deleted {
+				out = append(out, map[string]any{
+					"id":       25000001,
+					"username": "acctest-orphan.example",
+					"email":    "acctest-orphan@example.com",
+					"disabled": false,
+					"region":   "global",
+				})
+			}
+			_ = json.NewEncoder(w).Encode(out)
+		default:
+			writeErr(w, http.StatusMethodNotAllowed, "method not allowed", "")
+		}
+	})
+	mux.HandleFunc("/v3/subusers/", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case http.MethodPatch:
+			writeErr(w, http.StatusInternalServerError, "temporarily unavailable", "")
+		case http.MethodDelete:
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeErr(w, http.StatusMethodNotAllowed, "method not allowed", "")
+		}
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	config := mockProviderConfig(srv.URL) + `
+resource "sendgrid_subuser" "test" {
+  username = "acctest-orphan.example"
+  email    = "acctest-orphan@example.com"
+  password = "abc12345"
+  ips      = ["192.0.2.10"]
+  disabled = true
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testacc.TestAccProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			mu.Lock()
+			defer mu.Unlock()
+			if !deleted {
+				return fmt.Errorf("subuser created before the failed PATCH was not destroyed")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Update Subuser failed: temporarily unavailable`),
+			},
+		},
+	})
+}
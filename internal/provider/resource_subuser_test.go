@@ -2,7 +2,6 @@ package provider_test
 
 import (
 	"fmt"
-	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -12,19 +11,13 @@ import (
 
 // TestAccSubuserResource_basic exercises create, disable toggle, and import.
 //
-// Required environment variables (in addition to SENDGRID_API_KEY / TF_ACC):
-//   - TEST_SUBUSER_NEW_USERNAME: a username that does NOT yet exist
-//   - TEST_SUBUSER_NEW_EMAIL:    email for the new subuser
-//   - TEST_SUBUSER_PASSWORD:     password for the new subuser
-//   - TEST_SUBUSER_IP:           an IP address already assigned to the parent account
+// The subuser gets a random username and the first IP assigned to the parent
+// account (or TEST_SUBUSER_IP).
 func TestAccSubuserResource_basic(t *testing.T) {
-	username := os.Getenv("TEST_SUBUSER_NEW_USERNAME")
-	email := os.Getenv("TEST_SUBUSER_NEW_EMAIL")
-	password := os.Getenv("TEST_SUBUSER_PASSWORD")
-	ip := os.Getenv("TEST_SUBUSER_IP")
-	if username == "" || email == "" || password == "" || ip == "" {
-		t.Skip("TEST_SUBUSER_NEW_USERNAME/TEST_SUBUSER_NEW_EMAIL/TEST_SUBUSER_PASSWORD/TEST_SUBUSER_IP not set; skipping subuser resource test")
-	}
+	username := testacc.RandomName("tfacc")
+	email := username + "@example.com"
+	password := testacc.RandomPassword()
+	ip := testacc.SubuserIP(t)
 
 	config := func(disabled bool) string {
 		return fmt.Sprintf(`
@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sendgrid/sendgrid-go"
+)
+
+// NOTE: Action-style resource that removes entries from a global suppression
+// list. SendGrid adds entries to these lists itself (a bounce, a block, ...),
+// so there is nothing to create: the removal runs on create, changing any
+// argument runs it again, and destroy makes no API call.
+//
+// API Endpoints:
+//   - Delete addresses: DELETE /v3/suppression/{list}  body {"emails": [...]}
+//   - Delete all:       DELETE /v3/suppression/{list}  body {"delete_all": true}
+//   - Read/Update/Delete of the resource itself: no API calls
+//
+// API Documentation:
+//   - Bounces:        https://www.twilio.com/docs/sendgrid/api-reference/bounces-api/delete-bounces
+//   - Blocks:         https://www.twilio.com/docs/sendgrid/api-reference/blocks-api/delete-blocks
+//   - Spam reports:   https://www.twilio.com/docs/sendgrid/api-reference/spam-reports-api/delete-spam-reports
+//   - Invalid emails: https://www.twilio.com/docs/sendgrid/api-reference/invalid-e-mails-api/delete-invalid-emails
+//
+// One implementation serves every list; the constructors below only differ
+// in the list they target.
+
+var _ resource.Resource = (*SuppressionEntryResource)(nil)
+var _ resource.ResourceWithConfigure = (*SuppressionEntryResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*SuppressionEntryResource)(nil)
+
+// NewBounceEntryResource returns sendgrid_bounce_entry.
+func NewBounceEntryResource() resource.Resource {
+	return &SuppressionEntryResource{list: "bounces", typeName: "bounce_entry", noun: "bounce"}
+}
+
+// NewBlockEntryResource returns sendgrid_block_entry.
+func NewBlockEntryResource() resource.Resource {
+	return &SuppressionEntryResource{list: "blocks", typeName: "block_entry", noun: "block"}
+}
+
+// NewSpamReportEntryResource returns sendgrid_spam_report_entry.
+func NewSpamReportEntryResource() resource.Resource {
+	return &SuppressionEntryResource{list: "spam_reports", typeName: "spam_report_entry", noun: "spam report"}
+}
+
+// NewInvalidEmailEntryResource returns sendgrid_invalid_email_entry.
+func NewInvalidEmailEntryResource() resource.Resource {
+	return &SuppressionEntryResource{list: "invalid_emails", typeName: "invalid_email_entry", noun: "invalid email"}
+}
+
+// SuppressionEntryResource removes addresses from the suppression list
+// /v3/suppression/{list}.
+type SuppressionEntryResource struct {
+	client *Client
+
+	list     string // path segment under /v3/suppression
+	typeName string // resource type name without the provider prefix
+	noun     string // singular noun used in descriptions
+}
+
+type suppressionEntryModel struct {
+	ID               types.String `tfsdk:"id"`
+	Emails           types.Set    `tfsdk:"emails"`
+	DeleteAll        types.Bool   `tfsdk:"delete_all"`
+	ConfirmDeleteAll types.String `tfsdk:"confirm_delete_all"`
+	Triggers         types.Map    `tfsdk:"triggers"`
+	DeletedAt        types.String `tfsdk:"deleted_at"`
+}
+
+func (r *SuppressionEntryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.typeName
+}
+
+func (r *SuppressionEntryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pc, ok := req.ProviderData.(*Client)
+	if !ok || pc == nil {
+		resp.Diagnostics.AddError("Unexpected ProviderData",
+			"Expected *Client, got something else")
+		return
+	}
+	r.client = pc
+}
+
+func (r *SuppressionEntryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Remove addresses from the %s list (`/v3/suppression/%s`), or clear the whole list with `delete_all`. ", r.noun, r.list) +
+			"The removal runs on create; changing any argument (e.g. a value in `triggers`) runs it again. " +
+			"Destroying the resource makes no API call and does not restore removed entries.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("Identifier of this removal (`%s` and `deleted_at`).", r.list),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"emails": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Addresses to remove from the %s list. Addresses that are not on the list are ignored. Exactly one of `emails` or `delete_all` must be set.", r.noun),
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(3)),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"delete_all": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Remove every entry from the %s list. Requires `confirm_delete_all = %q`.", r.noun, r.list),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"confirm_delete_all": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Must be `%s` when `delete_all` is `true`, so clearing the list is always spelled out in configuration.", r.list),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Arbitrary values that run the removal again when changed, e.g. a ticket number.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"deleted_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "RFC 3339 timestamp of when the removal completed.",
+			},
+		},
+	}
+}
+
+// ValidateConfig requires exactly one of emails and delete_all, and the
+// confirmation with delete_all.
+func (r *SuppressionEntryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg suppressionEntryModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	validateSuppressionEntryConfig(r.list, cfg, &resp.Diagnostics)
+}
+
+// validateSuppressionEntryConfig holds the checks of ValidateConfig. Unknown
+// values are accepted; they are checked again once known.
+func validateSuppressionEntryConfig(list string, cfg suppressionEntryModel, diags *diag.Diagnostics) {
+	if cfg.Emails.IsUnknown() || cfg.DeleteAll.IsUnknown() || cfg.ConfirmDeleteAll.IsUnknown() {
+		return
+	}
+	deleteAll := cfg.DeleteAll.ValueBool()
+	switch {
+	case deleteAll && !cfg.Emails.IsNull():
+		diags.AddAttributeError(path.Root("emails"), "Conflicting arguments",
+			"emails cannot be combined with delete_all = true.")
+	case !deleteAll && cfg.Emails.IsNull():
+		diags.AddAttributeError(path.Root("emails"), "Missing argument",
+			"Set emails, or delete_all = true to clear the whole list.")
+	}
+	if deleteAll && cfg.ConfirmDeleteAll.ValueString() != list {
+		diags.AddAttributeError(path.Root("confirm_delete_all"), "Missing confirmation",
+			fmt.Sprintf("delete_all = true removes every entry from the %s list and requires confirm_delete_all = %q.", list, list))
+	}
+	if !deleteAll && !cfg.ConfirmDeleteAll.IsNull() {
+		diags.AddAttributeError(path.Root("confirm_delete_all"), "Unexpected argument",
+			"confirm_delete_all is only used with delete_all = true.")
+	}
+}
+
+// Create removes the configured addresses, or every entry with delete_all.
+func (r *SuppressionEntryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+
+	var plan suppressionEntryModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.requireScopes(ctx, "delete "+r.list, "suppression."+r.list+".delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.DeleteAll.ValueBool() {
+		resp.Diagnostics.Append(r.client.deleteAllSuppressions(ctx, r.list)...)
+	} else {
+		var emails []string
+		resp.Diagnostics.Append(plan.Emails.ElementsAs(ctx, &emails, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		_, diags := r.client.deleteSuppressions(ctx, r.list, emails)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deletedAt := time.Now().UTC().Format(time.RFC3339)
+	plan.DeletedAt = types.StringValue(deletedAt)
+	plan.ID = types.StringValue(r.list + "@" + deletedAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the recorded state; there is nothing to refresh.
+func (r *SuppressionEntryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state suppressionEntryModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called with a diff, since every argument forces replacement.
+func (r *SuppressionEntryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan suppressionEntryModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from state.
+func (r *SuppressionEntryResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// deleteAllSuppressions removes every entry from the given suppression list.
+// DELETE /v3/suppression/{list}
+func (c *Client) deleteAllSuppressions(ctx context.Context, list string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	b, _ := json.Marshal(map[string]bool{"delete_all": true})
+	reqSG := sendgrid.GetRequest(c.APIKey, "/v3/suppression/"+list, c.BaseURL)
+	reqSG.Method = "DELETE"
+	reqSG.Body = b
+
+	tflog.Debug(ctx, "DELETE /v3/suppression/"+list, map[string]any{"delete_all": true})
+
+	sgResp, err := sendgrid.API(reqSG)
+	c.invalidateGetCache()
+	if err != nil {
+		diags.AddError("SendGrid API error", err.Error())
+		return diags
+	}
+	diags.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+	if sgResp.StatusCode >= 300 {
+		diags.AddError(
+			fmt.Sprintf("Delete all %s failed: %s", list, apiErrorMessage(sgResp.Body)),
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+	}
+	return diags
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateSuppressionEntryConfig(t *testing.T) {
+	emails := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("a@example.com")})
+	cases := []struct {
+		name    string
+		cfg     suppressionEntryModel
+		wantErr bool
+	}{
+		{"emails", suppressionEntryModel{Emails: emails}, false},
+		{"nothing", suppressionEntryModel{Emails: types.SetNull(types.StringType)}, true},
+		{"delete all confirmed", suppressionEntryModel{Emails: types.SetNull(types.StringType), DeleteAll: types.BoolValue(true), ConfirmDeleteAll: types.StringValue("bounces")}, false},
+		{"delete all unconfirmed", suppressionEntryModel{Emails: types.SetNull(types.StringType), DeleteAll: types.BoolValue(true)}, true},
+		{"delete all wrong list", suppressionEntryModel{Emails: types.SetNull(types.StringType), DeleteAll: types.BoolValue(true), ConfirmDeleteAll: types.StringValue("blocks")}, true},
+		{"delete all with emails", suppressionEntryModel{Emails: emails, DeleteAll: types.BoolValue(true), ConfirmDeleteAll: types.StringValue("bounces")}, true},
+		{"confirmation without delete all", suppressionEntryModel{Emails: emails, ConfirmDeleteAll: types.StringValue("bounces")}, true},
+		{"unknown", suppressionEntryModel{Emails: types.SetUnknown(types.StringType)}, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateSuppressionEntryConfig("bounces", tc.cfg, &diags)
+			if diags.HasError() != tc.wantErr {
+				t.Fatalf("wantErr=%v, got %v", tc.wantErr, diags)
+			}
+		})
+	}
+}
+
+func TestDeleteAllSuppressions(t *testing.T) {
+	var body map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/v3/suppression/bounces" {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, APIKey: "test-key"}
+	if diags := c.deleteAllSuppressions(context.Background(), "bounces"); diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if body["delete_all"] != true || body["emails"] != nil {
+		t.Fatalf("unexpected body: %v", body)
+	}
+}
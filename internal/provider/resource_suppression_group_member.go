@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sendgrid/sendgrid-go"
+)
+
+// NOTE: This resource adds an email address to an unsubscribe (ASM) group.
+//
+// API Endpoints:
+//   - Create: POST   /v3/asm/groups/{group_id}/suppressions  {"recipient_emails": [...]}
+//   - Read:   POST   /v3/asm/groups/{group_id}/suppressions/search  {"recipient_emails": [...]}
+//   - Delete: DELETE /v3/asm/groups/{group_id}/suppressions/{email}
+//
+// API Documentation:
+//   - Add suppressions to a suppression group: https://www.twilio.com/docs/sendgrid/api-reference/suppressions-suppressions/add-suppressions-to-a-suppression-group
+//   - Search for suppressions within a group:  https://www.twilio.com/docs/sendgrid/api-reference/suppressions-suppressions/search-for-suppressions-within-a-group
+//   - Delete a suppression from a suppression group: https://www.twilio.com/docs/sendgrid/api-reference/suppressions-suppressions/delete-a-suppression-from-a-suppression-group
+//
+// Read searches for the one address rather than listing the group, which can
+// hold millions of suppressions.
+
+var _ resource.Resource = (*SuppressionGroupMemberResource)(nil)
+var _ resource.ResourceWithConfigure = (*SuppressionGroupMemberResource)(nil)
+var _ resource.ResourceWithImportState = (*SuppressionGroupMemberResource)(nil)
+
+func NewSuppressionGroupMemberResource() resource.Resource {
+	return &SuppressionGroupMemberResource{}
+}
+
+type SuppressionGroupMemberResource struct{ client *Client }
+
+type suppressionGroupMemberModel struct {
+	ID      types.String `tfsdk:"id"`
+	GroupID types.Int64  `tfsdk:"group_id"`
+	Email   types.String `tfsdk:"email"`
+}
+
+func (r *SuppressionGroupMemberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_suppression_group_member"
+}
+
+func (r *SuppressionGroupMemberResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pc, ok := req.ProviderData.(*Client)
+	if !ok || pc == nil {
+		resp.Diagnostics.AddError("Unexpected ProviderData",
+			"Expected *Client, got something else")
+		return
+	}
+	r.client = pc
+}
+
+func (r *SuppressionGroupMemberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Add an email address to an unsubscribe group via `POST /v3/asm/groups/{group_id}/suppressions`, e.g. to keep legal or compliance addresses suppressed. Destroying the resource removes the address from the group.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "`<group_id>/<email>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "ID of the unsubscribe group. Changing it forces replacement.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Email address to suppress. Changing it forces replacement.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(3),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Create adds the address to the group.
+func (r *SuppressionGroupMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	resp.Diagnostics.Append(r.client.requireScopes(ctx, "add suppression group member", "asm.groups.suppressions.create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var plan suppressionGroupMemberModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.client.addGroupSuppression(ctx, plan.GroupID.ValueInt64(), plan.Email.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = types.StringValue(suppressionGroupMemberID(plan.GroupID.ValueInt64(), plan.Email.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read removes the resource from state when the address is no longer in the
+// group, or the group is gone.
+func (r *SuppressionGroupMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	var state suppressionGroupMemberModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.client.groupSuppressionExists(ctx, state.GroupID.ValueInt64(), state.Email.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.ID = types.StringValue(suppressionGroupMemberID(state.GroupID.ValueInt64(), state.Email.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called with a diff, since every argument forces replacement.
+func (r *SuppressionGroupMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan suppressionGroupMemberModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the address from the group.
+func (r *SuppressionGroupMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	resp.Diagnostics.Append(r.client.requireScopes(ctx, "remove suppression group member", "asm.groups.suppressions.delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state suppressionGroupMemberModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.client.deleteGroupSuppression(ctx, state.GroupID.ValueInt64(), state.Email.ValueString())...)
+}
+
+// ImportState allows `terraform import sendgrid_suppression_group_member.example <group_id>/<email>`.
+func (r *SuppressionGroupMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	groupID, email, ok := strings.Cut(req.ID, "/")
+	id, err := strconv.ParseInt(groupID, 10, 64)
+	if !ok || err != nil || email == "" {
+		resp.Diagnostics.AddError("Invalid import ID",
+			fmt.Sprintf("expected <group_id>/<email> with a numeric group_id, got %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), email)...)
+}
+
+func suppressionGroupMemberID(groupID int64, email string) string {
+	return strconv.FormatInt(groupID, 10) + "/" + email
+}
+
+func groupSuppressionsPath(groupID int64) string {
+	return "/v3/asm/groups/" + strconv.FormatInt(groupID, 10) + "/suppressions"
+}
+
+// addGroupSuppression adds email to the unsubscribe group.
+// POST /v3/asm/groups/{group_id}/suppressions
+func (c *Client) addGroupSuppression(ctx context.Context, groupID int64, email string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	b, _ := json.Marshal(map[string][]string{"recipient_emails": {email}})
+	reqSG := sendgrid.GetRequest(c.APIKey, groupSuppressionsPath(groupID), c.BaseURL)
+	reqSG.Method = "POST"
+	reqSG.Body = b
+
+	tflog.Debug(ctx, "POST /v3/asm/groups/{group_id}/suppressions", map[string]any{"group_id": groupID})
+
+	sgResp, err := sendgrid.API(reqSG)
+	c.invalidateGetCache()
+	if err != nil {
+		diags.AddError("SendGrid API error", err.Error())
+		return diags
+	}
+	diags.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+	if sgResp.StatusCode >= 300 {
+		diags.AddError(
+			fmt.Sprintf("Add suppression to group %d failed: %s", groupID, apiErrorMessage(sgResp.Body)),
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+	}
+	return diags
+}
+
+// groupSuppressionExists reports whether email is suppressed in the group. A
+// group that does not exist (404) holds no suppressions.
+// POST /v3/asm/groups/{group_id}/suppressions/search
+func (c *Client) groupSuppressionExists(ctx context.Context, groupID int64, email string) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	b, _ := json.Marshal(map[string][]string{"recipient_emails": {email}})
+	reqSG := sendgrid.GetRequest(c.APIKey, groupSuppressionsPath(groupID)+"/search", c.BaseURL)
+	reqSG.Method = "POST"
+	reqSG.Body = b
+
+	tflog.Debug(ctx, "POST /v3/asm/groups/{group_id}/suppressions/search", map[string]any{"group_id": groupID})
+
+	sgResp, err := sendgrid.API(reqSG)
+	if err != nil {
+		diags.AddError("SendGrid API error", err.Error())
+		return false, diags
+	}
+	diags.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+	if sgResp.StatusCode == 404 {
+		return false, diags
+	}
+	if sgResp.StatusCode >= 300 {
+		diags.AddError(
+			fmt.Sprintf("Search suppressions of group %d failed: %s", groupID, apiErrorMessage(sgResp.Body)),
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+		return false, diags
+	}
+	var found []string
+	if err := json.Unmarshal([]byte(sgResp.Body), &found); err != nil {
+		diags.AddError("Parse error (group suppressions)", fmt.Sprintf("unable to parse body: %v", err))
+		return false, diags
+	}
+	for _, e := range found {
+		if strings.EqualFold(e, email) {
+			return true, diags
+		}
+	}
+	return false, diags
+}
+
+// deleteGroupSuppression removes email from the group. An address or group
+// that is already gone is not an error.
+// DELETE /v3/asm/groups/{group_id}/suppressions/{email}
+func (c *Client) deleteGroupSuppression(ctx context.Context, groupID int64, email string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	reqSG := sendgrid.GetRequest(c.APIKey, groupSuppressionsPath(groupID)+"/"+url.PathEscape(email), c.BaseURL)
+	reqSG.Method = "DELETE"
+
+	tflog.Debug(ctx, "DELETE /v3/asm/groups/{group_id}/suppressions/{email}", map[string]any{"group_id": groupID})
+
+	sgResp, err := sendgrid.API(reqSG)
+	c.invalidateGetCache()
+	if err != nil {
+		diags.AddError("SendGrid API error", err.Error())
+		return diags
+	}
+	diags.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+	if sgResp.StatusCode >= 300 && sgResp.StatusCode != 404 {
+		diags.AddError(
+			fmt.Sprintf("Delete suppression from group %d failed: %s", groupID, apiErrorMessage(sgResp.Body)),
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+	}
+	return diags
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGroupSuppressionClient(t *testing.T) {
+	members := map[string]bool{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v3/asm/groups/42/suppressions":
+			var body map[string][]string
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			for _, e := range body["recipient_emails"] {
+				members[e] = true
+			}
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(body)
+		case r.Method == http.MethodPost && r.URL.Path == "/v3/asm/groups/42/suppressions/search":
+			var body map[string][]string
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			found := []string{}
+			for _, e := range body["recipient_emails"] {
+				if members[e] {
+					found = append(found, e)
+				}
+			}
+			_ = json.NewEncoder(w).Encode(found)
+		case r.Method == http.MethodDelete && r.URL.Path == "/v3/asm/groups/42/suppressions/legal@example.com":
+			delete(members, "legal@example.com")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := &Client{BaseURL: srv.URL, APIKey: "test-key"}
+
+	if diags := c.addGroupSuppression(ctx, 42, "legal@example.com"); diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if found, diags := c.groupSuppressionExists(ctx, 42, "legal@example.com"); diags.HasError() || !found {
+		t.Fatalf("expected member, got found=%v diags=%v", found, diags)
+	}
+	if diags := c.deleteGroupSuppression(ctx, 42, "legal@example.com"); diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if found, diags := c.groupSuppressionExists(ctx, 42, "legal@example.com"); diags.HasError() || found {
+		t.Fatalf("expected no member, got found=%v diags=%v", found, diags)
+	}
+	// Unknown groups hold no suppressions, and deleting from them is a no-op.
+	if found, diags := c.groupSuppressionExists(ctx, 7, "legal@example.com"); diags.HasError() || found {
+		t.Fatalf("expected no member, got found=%v diags=%v", found, diags)
+	}
+	if diags := c.deleteGroupSuppression(ctx, 7, "legal@example.com"); diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+}
@@ -0,0 +1,282 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sendgrid/sendgrid-go"
+)
+
+// NOTE: Action-style resource that deletes old entries from a suppression list.
+//
+// API Endpoints:
+//   - List:   GET    /v3/suppression/{list}?end_time={unix}&limit&offset
+//   - Delete: DELETE /v3/suppression/{list}  body {"emails": [...]}
+//   - Read/Update/Delete of the resource itself: no API calls
+//
+// API Documentation:
+//   - Bounces: https://www.twilio.com/docs/sendgrid/api-reference/bounces-api
+//   - Blocks:  https://www.twilio.com/docs/sendgrid/api-reference/blocks-api
+//
+// The delete endpoints have no "older than" filter, so entries created before
+// older_than are listed first and then deleted by address in batches.
+
+// suppressionPurgePageSize is the limit used when listing suppressions, and
+// suppressionPurgeBatchSize the number of addresses per DELETE request.
+var (
+	suppressionPurgePageSize  = 500
+	suppressionPurgeBatchSize = 500
+)
+
+var _ resource.Resource = (*SuppressionPurgeResource)(nil)
+var _ resource.ResourceWithConfigure = (*SuppressionPurgeResource)(nil)
+
+func NewSuppressionPurgeResource() resource.Resource { return &SuppressionPurgeResource{} }
+
+type SuppressionPurgeResource struct{ client *Client }
+
+type suppressionPurgeModel struct {
+	ID           types.String `tfsdk:"id"`
+	List         types.String `tfsdk:"list"`
+	OlderThan    types.String `tfsdk:"older_than"`
+	Triggers     types.Map    `tfsdk:"triggers"`
+	DeletedCount types.Int64  `tfsdk:"deleted_count"`
+	PurgedAt     types.String `tfsdk:"purged_at"`
+}
+
+func (r *SuppressionPurgeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_suppression_purge"
+}
+
+func (r *SuppressionPurgeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pc, ok := req.ProviderData.(*Client)
+	if !ok || pc == nil {
+		resp.Diagnostics.AddError("Unexpected ProviderData",
+			"Expected *Client, got something else")
+		return
+	}
+	r.client = pc
+}
+
+func (r *SuppressionPurgeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Delete entries older than a given time from the bounce or block suppression list. The purge runs on create; changing any argument (e.g. a value in `triggers`) runs it again. Destroying the resource makes no API call and does not restore deleted entries.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of this purge run (`list` and `purged_at`).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"list": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Suppression list to purge: `bounces` or `blocks`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("bounces", "blocks"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"older_than": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "RFC 3339 timestamp. Entries created before this time are deleted. Avoid `timestamp()` or `plantimestamp()` here: they change on every plan and would run the purge on every apply.",
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Arbitrary values that run the purge again when changed, e.g. a schedule date.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"deleted_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of entries deleted by the purge.",
+			},
+			"purged_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "RFC 3339 timestamp of when the purge completed.",
+			},
+		},
+	}
+}
+
+// Create lists and deletes the entries older than older_than.
+func (r *SuppressionPurgeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+
+	var plan suppressionPurgeModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	olderThan, err := time.Parse(time.RFC3339, plan.OlderThan.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("older_than"), "Invalid older_than", err.Error())
+		return
+	}
+
+	list := plan.List.ValueString()
+	resp.Diagnostics.Append(r.client.requireScopes(ctx, "purge "+list, "suppression."+list+".read", "suppression."+list+".delete")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	emails, diags := r.client.suppressionsBefore(ctx, list, olderThan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleted, diags := r.client.deleteSuppressions(ctx, list, emails)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	purgedAt := time.Now().UTC().Format(time.RFC3339)
+	plan.DeletedCount = types.Int64Value(int64(deleted))
+	plan.PurgedAt = types.StringValue(purgedAt)
+	plan.ID = types.StringValue(list + "@" + purgedAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the recorded state; there is nothing to refresh.
+func (r *SuppressionPurgeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state suppressionPurgeModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called with a diff, since every argument forces replacement.
+func (r *SuppressionPurgeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan suppressionPurgeModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from state.
+func (r *SuppressionPurgeResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// suppressionsBefore returns the addresses on the given suppression list that
+// were added before t, walking every page.
+func (c *Client) suppressionsBefore(ctx context.Context, list string, t time.Time) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var emails []string
+	for offset := 0; ; offset += suppressionPurgePageSize {
+		var page []struct {
+			Email   string `json:"email"`
+			Created int64  `json:"created"`
+		}
+		query := map[string]string{
+			"end_time": strconv.FormatInt(t.Unix(), 10),
+			"limit":    strconv.Itoa(suppressionPurgePageSize),
+			"offset":   strconv.Itoa(offset),
+		}
+		if !c.getJSON(ctx, "/v3/suppression/"+list, query, &page, &diags) {
+			return nil, diags
+		}
+		for _, e := range page {
+			// end_time is inclusive; keep the purge strictly "older than".
+			if e.Created < t.Unix() {
+				emails = append(emails, e.Email)
+			}
+		}
+		if len(page) < suppressionPurgePageSize {
+			return emails, diags
+		}
+	}
+}
+
+// deleteSuppressions removes emails from the given suppression list in
+// batches of suppressionPurgeBatchSize and returns how many were deleted. On
+// failure the count of already deleted entries is part of the error.
+func (c *Client) deleteSuppressions(ctx context.Context, list string, emails []string) (int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	deleted := 0
+	for start := 0; start < len(emails); start += suppressionPurgeBatchSize {
+		end := min(start+suppressionPurgeBatchSize, len(emails))
+		b, _ := json.Marshal(map[string][]string{"emails": emails[start:end]})
+		reqSG := sendgrid.GetRequest(c.APIKey, "/v3/suppression/"+list, c.BaseURL)
+		reqSG.Method = "DELETE"
+		reqSG.Body = b
+
+		tflog.Debug(ctx, "DELETE /v3/suppression/"+list, map[string]any{"count": end - start})
+
+		sgResp, err := sendgrid.API(reqSG)
+		c.invalidateGetCache()
+		if err != nil {
+			diags.AddError("SendGrid API error",
+				fmt.Sprintf("%v (%d of %d entries were already deleted)", err, deleted, len(emails)))
+			return deleted, diags
+		}
+		diags.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+		if sgResp.StatusCode >= 300 {
+			diags.AddError(
+				fmt.Sprintf("Delete %s failed: %s", list, apiErrorMessage(sgResp.Body)),
+				fmt.Sprintf("status=%d body=%s (%d of %d entries were already deleted)", sgResp.StatusCode, sgResp.Body, deleted, len(emails)))
+			return deleted, diags
+		}
+		deleted = end
+	}
+	return deleted, diags
+}
+
+// rfc3339Validator requires a string attribute to hold an RFC 3339 timestamp.
+type rfc3339Validator struct{}
+
+var _ validator.String = rfc3339Validator{}
+
+func (v rfc3339Validator) Description(_ context.Context) string {
+	return "value must be an RFC 3339 timestamp"
+}
+
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rfc3339Validator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid timestamp",
+			fmt.Sprintf("%s must be an RFC 3339 timestamp such as 2025-01-02T15:04:05Z: %v", req.Path, err))
+	}
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSuppressionPurge_ListsAndDeletesInBatches(t *testing.T) {
+	oldPage, oldBatch := suppressionPurgePageSize, suppressionPurgeBatchSize
+	suppressionPurgePageSize, suppressionPurgeBatchSize = 2, 2
+	defer func() { suppressionPurgePageSize, suppressionPurgeBatchSize = oldPage, oldBatch }()
+
+	cutoff := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	entries := []map[string]any{
+		{"email": "a@example.com", "created": cutoff.Unix() - 300},
+		{"email": "b@example.com", "created": cutoff.Unix() - 200},
+		{"email": "c@example.com", "created": cutoff.Unix() - 100},
+		{"email": "edge@example.com", "created": cutoff.Unix()},
+	}
+	var deleted [][]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/suppression/bounces" {
+			http.NotFound(w, r)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			if got := r.URL.Query().Get("end_time"); got != strconv.FormatInt(cutoff.Unix(), 10) {
+				t.Errorf("end_time = %q", got)
+			}
+			offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+			end := min(offset+2, len(entries))
+			_ = json.NewEncoder(w).Encode(entries[min(offset, end):end])
+		case http.MethodDelete:
+			var body struct {
+				Emails []string `json:"emails"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			deleted = append(deleted, body.Emails)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, APIKey: "test-key"}
+	emails, diags := c.suppressionsBefore(context.Background(), "bounces", cutoff)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if len(emails) != 3 {
+		t.Fatalf("expected 3 entries strictly before the cutoff, got %v", emails)
+	}
+	n, diags := c.deleteSuppressions(context.Background(), "bounces", emails)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if n != 3 || len(deleted) != 2 || len(deleted[0]) != 2 || deleted[1][0] != "c@example.com" {
+		t.Fatalf("unexpected deletes: n=%d batches=%v", n, deleted)
+	}
+}
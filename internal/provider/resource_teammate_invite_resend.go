@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sendgrid/sendgrid-go"
+)
+
+// NOTE: Action-style resource that resends a pending teammate invitation.
+//
+// API Endpoints:
+//   - Lookup: GET  /v3/teammates/pending
+//   - Resend: POST /v3/teammates/pending/{token}/resend
+//   - Read/Update/Delete: no API calls
+//
+// API Documentation:
+//   - Retrieve all pending teammates: https://www.twilio.com/docs/sendgrid/api-reference/teammates/retrieve-all-pending-teammates
+//   - Resend teammate invite:         https://www.twilio.com/docs/sendgrid/api-reference/teammates/resend-teammate-invite
+//
+// Invitations expire after seven days. The invitation token is looked up by
+// email on every create, so bumping a value in `triggers` resends the current
+// invitation without the token ever appearing in configuration.
+
+var _ resource.Resource = (*TeammateInviteResendResource)(nil)
+var _ resource.ResourceWithConfigure = (*TeammateInviteResendResource)(nil)
+
+func NewTeammateInviteResendResource() resource.Resource { return &TeammateInviteResendResource{} }
+
+type TeammateInviteResendResource struct{ client *Client }
+
+type teammateInviteResendModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Email                types.String `tfsdk:"email"`
+	OnBehalfOf           types.String `tfsdk:"on_behalf_of"`
+	Triggers             types.Map    `tfsdk:"triggers"`
+	InviteExpirationDate types.Int64  `tfsdk:"invite_expiration_date"`
+	ResentAt             types.String `tfsdk:"resent_at"`
+}
+
+func (r *TeammateInviteResendResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_teammate_invite_resend"
+}
+
+func (r *TeammateInviteResendResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pc, ok := req.ProviderData.(*Client)
+	if !ok || pc == nil {
+		resp.Diagnostics.AddError("Unexpected ProviderData",
+			"Expected *Client, got something else")
+		return
+	}
+	r.client = pc
+}
+
+func (r *TeammateInviteResendResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	replace := []planmodifier.String{stringplanmodifier.RequiresReplace()}
+	computed := []planmodifier.String{stringplanmodifier.UseStateForUnknown()}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resend the pending invitation of a teammate via `POST /v3/teammates/pending/{token}/resend`, e.g. after it expired (invitations are valid for seven days). The invitation is resent on create and fails when no invitation to `email` is pending; changing any argument (e.g. a value in `triggers`) resends it again. Destroying the resource makes no API call.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of this resend (the `email` and `resent_at` timestamp).",
+				PlanModifiers:       computed,
+			},
+			"email": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Email address the pending invitation was sent to (compared case-insensitively).",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: replace,
+			},
+			"on_behalf_of": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Parent account header to impersonate a Subuser: sets the HTTP header `on-behalf-of` to the given subuser username.",
+				PlanModifiers:       replace,
+			},
+			"triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Arbitrary values that resend the invitation when changed, e.g. a date bumped whenever the invitation expired.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"invite_expiration_date": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Unix timestamp (seconds) at which the resent invitation expires, when returned by SendGrid.",
+			},
+			"resent_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "RFC 3339 timestamp of when the invitation was resent.",
+				PlanModifiers:       computed,
+			},
+		},
+	}
+}
+
+// Create looks up the pending invitation and resends it.
+func (r *TeammateInviteResendResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+	resp.Diagnostics.Append(r.client.requireScopes(ctx, "resend teammate invitation", "teammates.create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan teammateInviteResendModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	email := plan.Email.ValueString()
+	onBehalfOf := plan.OnBehalfOf.ValueString()
+	inv, found, diags := r.client.findPendingTeammateInvite(ctx, email, onBehalfOf)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Teammate invitation not pending",
+			fmt.Sprintf("No pending teammate invitation to %q (GET /v3/teammates/pending). It may already have been accepted or cancelled.", email))
+		return
+	}
+
+	resent, diags := r.client.resendPendingTeammate(ctx, inv.Token, onBehalfOf)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resentAt := time.Now().UTC().Format(time.RFC3339)
+	plan.ID = types.StringValue(email + "@" + resentAt)
+	plan.ResentAt = types.StringValue(resentAt)
+	plan.InviteExpirationDate = types.Int64Null()
+	if resent.ExpirationDate > 0 {
+		plan.InviteExpirationDate = types.Int64Value(resent.ExpirationDate)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the recorded state; there is nothing to refresh.
+func (r *TeammateInviteResendResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state teammateInviteResendModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called with a diff, since every argument forces replacement.
+func (r *TeammateInviteResendResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan teammateInviteResendModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from state.
+func (r *TeammateInviteResendResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// resendPendingTeammate resends the invitation identified by token and
+// returns the invitation as echoed by SendGrid.
+// POST /v3/teammates/pending/{token}/resend
+func (c *Client) resendPendingTeammate(ctx context.Context, token, onBehalfOf string) (pendingTeammateInvite, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var out pendingTeammateInvite
+
+	reqSG := sendgrid.GetRequest(c.APIKey, "/v3/teammates/pending/"+token+"/resend", c.BaseURL)
+	reqSG.Method = "POST"
+	if onBehalfOf != "" {
+		reqSG.Headers["on-behalf-of"] = onBehalfOf
+	}
+	tflog.Debug(ctx, "POST /v3/teammates/pending/{token}/resend")
+
+	sgResp, err := sendgrid.API(reqSG)
+	c.invalidateGetCache()
+	if err != nil {
+		diags.AddError("SendGrid API error", err.Error())
+		return out, diags
+	}
+	diags.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+	if sgResp.StatusCode >= 300 {
+		diags.AddError(
+			fmt.Sprintf("Resend teammate invitation failed: %s", apiErrorMessage(sgResp.Body)),
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+		return out, diags
+	}
+	if sgResp.Body != "" {
+		if err := json.Unmarshal([]byte(sgResp.Body), &out); err != nil {
+			diags.AddError("Parse error (resend teammate invitation)", fmt.Sprintf("unable to parse body: %v", err))
+		}
+	}
+	return out, diags
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTeammateInviteResend_FindAndResend(t *testing.T) {
+	var resent []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v3/teammates/pending":
+			_, _ = w.Write([]byte(`{"result":[{"email":"Pat@Example.com","scopes":["mail.send"],"is_admin":false,"token":"tok-1","expiration_date":1700000000}]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/v3/teammates/pending/tok-1/resend":
+			resent = append(resent, r.Header.Get("on-behalf-of"))
+			_, _ = w.Write([]byte(`{"email":"Pat@Example.com","scopes":["mail.send"],"is_admin":false,"token":"tok-1","expiration_date":1700600000}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := &Client{BaseURL: srv.URL, APIKey: "test-key"}
+
+	inv, found, diags := c.findPendingTeammateInvite(ctx, "pat@example.com", "")
+	if diags.HasError() || !found || inv.Token != "tok-1" {
+		t.Fatalf("find: inv=%+v found=%v diags=%v", inv, found, diags)
+	}
+	got, diags := c.resendPendingTeammate(ctx, inv.Token, "sub1")
+	if diags.HasError() {
+		t.Fatalf("resend: %v", diags)
+	}
+	if got.ExpirationDate != 1700600000 || len(resent) != 1 || resent[0] != "sub1" {
+		t.Fatalf("resend: got=%+v calls=%v", got, resent)
+	}
+
+	if _, diags := c.resendPendingTeammate(ctx, "missing", ""); !diags.HasError() {
+		t.Fatal("expected an error for an unknown token")
+	}
+}
@@ -0,0 +1,379 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sendgrid/sendgrid-go"
+)
+
+// NOTE: Action-style resource that sends a test email rendered from a
+// dynamic template.
+//
+// API Endpoints:
+//   - Create: POST /v3/mail/send
+//   - Create (version_id set): POST /v3/marketing/test/send_email
+//   - Read/Update/Delete: no API calls
+//
+// API Documentation:
+//   - Mail Send:                   https://www.twilio.com/docs/sendgrid/api-reference/mail-send/mail-send
+//   - Send a Test Marketing Email: https://www.twilio.com/docs/sendgrid/api-reference/send-test-email/send-test-marketing-email
+//
+// With sandbox_mode = true SendGrid validates and renders the request without
+// delivering it, which is enough to smoke-test a template deployment. Mail
+// Send always renders the active version; to test a version before activating
+// it, version_id switches to the test send endpoint, which accepts a version
+// override but neither template data nor sandbox mode.
+
+// templateVersionTestMaxRecipients is the recipient limit of
+// POST /v3/marketing/test/send_email.
+const templateVersionTestMaxRecipients = 10
+
+var _ resource.Resource = (*TemplateTestEmailResource)(nil)
+var _ resource.ResourceWithConfigure = (*TemplateTestEmailResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*TemplateTestEmailResource)(nil)
+
+func NewTemplateTestEmailResource() resource.Resource { return &TemplateTestEmailResource{} }
+
+type TemplateTestEmailResource struct{ client *Client }
+
+type templateTestEmailModel struct {
+	ID                  types.String `tfsdk:"id"`
+	TemplateID          types.String `tfsdk:"template_id"`
+	VersionID           types.String `tfsdk:"version_id"`
+	FromEmail           types.String `tfsdk:"from_email"`
+	FromName            types.String `tfsdk:"from_name"`
+	To                  types.Set    `tfsdk:"to"`
+	DynamicTemplateData types.String `tfsdk:"dynamic_template_data"`
+	SandboxMode         types.Bool   `tfsdk:"sandbox_mode"`
+	Triggers            types.Map    `tfsdk:"triggers"`
+	MessageID           types.String `tfsdk:"message_id"`
+	SentAt              types.String `tfsdk:"sent_at"`
+}
+
+func (r *TemplateTestEmailResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_template_test_email"
+}
+
+func (r *TemplateTestEmailResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pc, ok := req.ProviderData.(*Client)
+	if !ok || pc == nil {
+		resp.Diagnostics.AddError("Unexpected ProviderData",
+			"Expected *Client, got something else")
+		return
+	}
+	r.client = pc
+}
+
+func (r *TemplateTestEmailResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	replace := []planmodifier.String{stringplanmodifier.RequiresReplace()}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Send a test email rendered from a dynamic template via `POST /v3/mail/send`. The email is sent on create; changing any argument (e.g. a value in `triggers`) sends it again. Destroying the resource makes no API call.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Same as `message_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"template_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Dynamic template ID (`d-...`). The template's active version is rendered unless `version_id` is set.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: replace,
+			},
+			"version_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("ID of a template version to render instead of the active one, e.g. a version about to be activated. Sends through `POST /v3/marketing/test/send_email`, which allows at most %d recipients and supports neither `dynamic_template_data` nor `sandbox_mode`.", templateVersionTestMaxRecipients),
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("dynamic_template_data")),
+				},
+				PlanModifiers: replace,
+			},
+			"from_email": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Sender address. Must be a verified sender or on an authenticated domain.",
+				PlanModifiers:       replace,
+			},
+			"from_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Sender display name.",
+				PlanModifiers:       replace,
+			},
+			"to": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				MarkdownDescription: "Recipient addresses. Each recipient gets the same rendered email.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"dynamic_template_data": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "JSON object with the Handlebars data used to render the template, e.g. `jsonencode({ name = \"Test\" })`.",
+				Validators: []validator.String{
+					jsonObjectValidator{},
+				},
+				PlanModifiers: replace,
+			},
+			"sandbox_mode": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "If true, SendGrid validates and renders the request without delivering it.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Arbitrary values that send the test email again when changed, e.g. the ID of the template version just deployed.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"message_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "`X-Message-Id` returned by SendGrid, usable to look the message up in Email Activity. Empty in sandbox mode and with `version_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sent_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "RFC 3339 timestamp of when SendGrid accepted the email.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ---------- API payloads ----------
+
+type mailAddress struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+type mailPersonalization struct {
+	To                  []mailAddress   `json:"to"`
+	DynamicTemplateData json.RawMessage `json:"dynamic_template_data,omitempty"`
+}
+
+type mailSendPayload struct {
+	Personalizations []mailPersonalization `json:"personalizations"`
+	From             mailAddress           `json:"from"`
+	TemplateID       string                `json:"template_id"`
+	MailSettings     *mailSendSettings     `json:"mail_settings,omitempty"`
+}
+
+type mailSendSettings struct {
+	SandboxMode struct {
+		Enable bool `json:"enable"`
+	} `json:"sandbox_mode"`
+}
+
+type templateVersionTestPayload struct {
+	TemplateID        string   `json:"template_id"`
+	VersionIDOverride string   `json:"version_id_override"`
+	Emails            []string `json:"emails"`
+	FromAddress       string   `json:"from_address"`
+}
+
+// ValidateConfig rejects arguments the test send endpoint does not support
+// when version_id is set.
+func (r *TemplateTestEmailResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg templateTestEmailModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() || cfg.VersionID.IsNull() {
+		return
+	}
+	if cfg.SandboxMode.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("sandbox_mode"), "Invalid sandbox_mode",
+			"sandbox_mode is not supported together with version_id.")
+	}
+	if !cfg.To.IsUnknown() && len(cfg.To.Elements()) > templateVersionTestMaxRecipients {
+		resp.Diagnostics.AddAttributeError(path.Root("to"), "Too many recipients",
+			fmt.Sprintf("At most %d recipients are allowed together with version_id, got %d.", templateVersionTestMaxRecipients, len(cfg.To.Elements())))
+	}
+}
+
+// Create sends the test email.
+// POST /v3/mail/send, or POST /v3/marketing/test/send_email with version_id
+func (r *TemplateTestEmailResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+
+	var plan templateTestEmailModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var to []string
+	resp.Diagnostics.Append(plan.To.ElementsAs(ctx, &to, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := "/v3/mail/send"
+	var b []byte
+	var err error
+	if versionID := plan.VersionID.ValueString(); versionID != "" {
+		resp.Diagnostics.Append(r.client.requireScopes(ctx, "send test marketing email", "marketing.send")...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		p = "/v3/marketing/test/send_email"
+		b, err = json.Marshal(templateVersionTestPayload{
+			TemplateID:        plan.TemplateID.ValueString(),
+			VersionIDOverride: versionID,
+			Emails:            to,
+			FromAddress:       plan.FromEmail.ValueString(),
+		})
+	} else {
+		resp.Diagnostics.Append(r.client.requireScopes(ctx, "send test email", "mail.send")...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		b, err = plan.mailSendBody(to)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid dynamic_template_data", err.Error())
+		return
+	}
+	reqSG := sendgrid.GetRequest(r.client.APIKey, p, r.client.BaseURL)
+	reqSG.Method = "POST"
+	reqSG.Body = b
+
+	tflog.Debug(ctx, "POST "+p, map[string]any{"template_id": plan.TemplateID.ValueString(), "recipients": len(to)})
+
+	sgResp, err := sendgrid.API(reqSG)
+	r.client.invalidateGetCache()
+	if err != nil {
+		resp.Diagnostics.AddError("SendGrid API error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+	if sgResp.StatusCode >= 300 {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Send test email failed: %s", apiErrorMessage(sgResp.Body)),
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+		return
+	}
+
+	var messageID string
+	if ids := sgResp.Headers["X-Message-Id"]; len(ids) > 0 {
+		messageID = ids[0]
+	}
+	sentAt := time.Now().UTC().Format(time.RFC3339)
+	plan.MessageID = types.StringValue(messageID)
+	plan.SentAt = types.StringValue(sentAt)
+	if messageID != "" {
+		plan.ID = types.StringValue(messageID)
+	} else {
+		plan.ID = types.StringValue(plan.TemplateID.ValueString() + "@" + sentAt)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// mailSendBody builds the POST /v3/mail/send body for m and recipients to.
+func (m templateTestEmailModel) mailSendBody(to []string) ([]byte, error) {
+	// One personalization per recipient, so recipients don't see each other.
+	payload := mailSendPayload{
+		From:       mailAddress{Email: m.FromEmail.ValueString(), Name: m.FromName.ValueString()},
+		TemplateID: m.TemplateID.ValueString(),
+	}
+	for _, addr := range to {
+		p := mailPersonalization{To: []mailAddress{{Email: addr}}}
+		if data := m.DynamicTemplateData.ValueString(); data != "" {
+			p.DynamicTemplateData = json.RawMessage(data)
+		}
+		payload.Personalizations = append(payload.Personalizations, p)
+	}
+	if m.SandboxMode.ValueBool() {
+		payload.MailSettings = &mailSendSettings{}
+		payload.MailSettings.SandboxMode.Enable = true
+	}
+	return json.Marshal(payload)
+}
+
+// Read keeps the recorded state; there is nothing to refresh.
+func (r *TemplateTestEmailResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state templateTestEmailModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called with a diff, since every argument forces replacement.
+func (r *TemplateTestEmailResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan templateTestEmailModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from state.
+func (r *TemplateTestEmailResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// jsonObjectValidator requires a string attribute to hold a JSON object.
+type jsonObjectValidator struct{}
+
+var _ validator.String = jsonObjectValidator{}
+
+func (v jsonObjectValidator) Description(_ context.Context) string {
+	return "value must be a JSON object"
+}
+
+func (v jsonObjectValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonObjectValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	var obj map[string]any
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &obj); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid JSON object",
+			fmt.Sprintf("%s must be a JSON object (e.g. jsonencode({...})): %v", req.Path, err))
+	}
+}
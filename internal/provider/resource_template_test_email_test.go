@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestJSONObjectValidator(t *testing.T) {
+	cases := map[string]bool{
+		`{"name":"Test","items":[1,2]}`: true,
+		`{}`:                            true,
+		`[1,2]`:                         false,
+		`{"name":`:                      false,
+	}
+	for in, ok := range cases {
+		resp := &validator.StringResponse{}
+		jsonObjectValidator{}.ValidateString(context.Background(), validator.StringRequest{
+			Path:        path.Root("dynamic_template_data"),
+			ConfigValue: types.StringValue(in),
+		}, resp)
+		if resp.Diagnostics.HasError() == ok {
+			t.Errorf("%s: valid = %t, want %t", in, !resp.Diagnostics.HasError(), ok)
+		}
+	}
+}
+
+func TestTemplateTestEmail_MailSendBody(t *testing.T) {
+	m := templateTestEmailModel{
+		TemplateID:          types.StringValue("d-123"),
+		FromEmail:           types.StringValue("qa@example.com"),
+		DynamicTemplateData: types.StringValue(`{"name":"Test"}`),
+		SandboxMode:         types.BoolValue(true),
+	}
+	b, err := m.mailSendBody([]string{"a@example.com", "b@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"personalizations":[{"to":[{"email":"a@example.com"}],"dynamic_template_data":{"name":"Test"}},{"to":[{"email":"b@example.com"}],"dynamic_template_data":{"name":"Test"}}],"from":{"email":"qa@example.com"},"template_id":"d-123","mail_settings":{"sandbox_mode":{"enable":true}}}`
+	if string(b) != want {
+		t.Fatalf("mailSendBody =\n%s\nwant\n%s", b, want)
+	}
+}
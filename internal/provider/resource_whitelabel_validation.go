@@ -0,0 +1,415 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sendgrid/sendgrid-go"
+)
+
+// NOTE: Action-style resources that (re-)run sender authentication validation.
+//
+// API Endpoints:
+//   - Domain authentication: POST /v3/whitelabel/domains/{id}/validate
+//   - Link branding:         POST /v3/whitelabel/links/{id}/validate
+//   - Reverse DNS:           POST /v3/whitelabel/ips/{id}/validate
+//   - Read/Update/Delete: no API calls
+//
+// API Documentation:
+//   - Validate a domain authentication: https://www.twilio.com/docs/sendgrid/api-reference/domain-authentication/validate-a-domain-authentication
+//   - Validate a branded link:          https://www.twilio.com/docs/sendgrid/api-reference/link-branding/validate-a-branded-link
+//   - Validate a reverse DNS record:    https://www.twilio.com/docs/sendgrid/api-reference/reverse-dns/validate-a-reverse-dns-record
+//
+// Validation runs on create. Changing the target ID or any value in `triggers`
+// runs it again, so operators can re-validate after fixing DNS without
+// touching the domain, link or IP itself. With `wait_timeout` set, validation is
+// retried until it passes, which covers DNS records that are still
+// propagating when the apply reaches this resource.
+
+// whitelabelValidationPollInterval is the delay between validation attempts
+// when wait_timeout is set. A variable so tests can shorten it.
+var whitelabelValidationPollInterval = 15 * time.Second
+
+var _ resource.Resource = (*whitelabelValidationResource)(nil)
+var _ resource.ResourceWithConfigure = (*whitelabelValidationResource)(nil)
+
+// whitelabelValidationResource implements all validation resources; they only
+// differ in type name, target attribute and API path.
+type whitelabelValidationResource struct {
+	client *Client
+
+	typeSuffix  string // e.g. "_domain_authentication_validation"
+	targetAttr  string // e.g. "domain_id"
+	targetLabel string // e.g. "authenticated domain"
+	article     string // "a" or "an", for targetLabel in descriptions
+	apiPath     string // e.g. "/v3/whitelabel/domains"
+}
+
+func NewDomainAuthenticationValidationResource() resource.Resource {
+	return &whitelabelValidationResource{
+		typeSuffix:  "_domain_authentication_validation",
+		targetAttr:  "domain_id",
+		targetLabel: "authenticated domain",
+		article:     "an",
+		apiPath:     "/v3/whitelabel/domains",
+	}
+}
+
+func NewLinkBrandingValidationResource() resource.Resource {
+	return &whitelabelValidationResource{
+		typeSuffix:  "_link_branding_validation",
+		targetAttr:  "link_id",
+		targetLabel: "branded link",
+		article:     "a",
+		apiPath:     "/v3/whitelabel/links",
+	}
+}
+
+func NewReverseDNSValidationResource() resource.Resource {
+	return &whitelabelValidationResource{
+		typeSuffix:  "_reverse_dns_validation",
+		targetAttr:  "reverse_dns_id",
+		targetLabel: "reverse DNS record",
+		article:     "a",
+		apiPath:     "/v3/whitelabel/ips",
+	}
+}
+
+// whitelabelValidationModel is decoded attribute by attribute because the
+// target attribute name differs between the resources.
+type whitelabelValidationModel struct {
+	ID                types.String
+	TargetID          types.String
+	Triggers          types.Map
+	RequireValid      types.Bool
+	WaitTimeout       types.String
+	Valid             types.Bool
+	Reason            types.String
+	ValidationResults types.Map
+}
+
+func validationResultObjectType() types.ObjectType {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"valid":  types.BoolType,
+		"reason": types.StringType,
+	}}
+}
+
+func (r *whitelabelValidationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.typeSuffix
+}
+
+func (r *whitelabelValidationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	pc, ok := req.ProviderData.(*Client)
+	if !ok || pc == nil {
+		resp.Diagnostics.AddError("Unexpected ProviderData",
+			"Expected *Client, got something else")
+		return
+	}
+	r.client = pc
+}
+
+func (r *whitelabelValidationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Validate the DNS records of %s %s via `POST %s/{id}/validate`. Validation runs on create; changing `%s` or any value in `triggers` runs it again. Destroying the resource makes no API call.", r.article, r.targetLabel, r.apiPath, r.targetAttr),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("Same as `%s`.", r.targetAttr),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			r.targetAttr: schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: fmt.Sprintf("ID of the %s to validate.", r.targetLabel),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Arbitrary values that re-run validation when changed, e.g. the IDs of the DNS records created for it.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"require_valid": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "If true (default), the apply fails when validation does not pass, listing the failing records. If false, the results are recorded and a warning is emitted instead.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_timeout": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Keep retrying validation every %s until it passes or this much time has elapsed, as a positive Go duration (e.g., `10m`). When omitted, validation runs once.", whitelabelValidationPollInterval),
+				Validators: []validator.String{
+					durationValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"valid": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether every record passed validation.",
+			},
+			"reason": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Failing records and their reasons, one per line. Null when validation passed.",
+			},
+			"validation_results": schema.MapNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Per-record results keyed by record name (e.g. `mail_cname`, `dkim1`, `domain_cname`).",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"valid": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether this record passed validation.",
+						},
+						"reason": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Reason the record failed validation, if any.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// whitelabelValidationResponse is the body returned by both validate endpoints.
+type whitelabelValidationResponse struct {
+	ID                int64 `json:"id"`
+	Valid             bool  `json:"valid"`
+	ValidationResults map[string]struct {
+		Valid  bool    `json:"valid"`
+		Reason *string `json:"reason"`
+	} `json:"validation_results"`
+}
+
+// getModel decodes plan or state into m via GetAttribute.
+func (r *whitelabelValidationResource) getModel(ctx context.Context, get func(context.Context, path.Path, any) diag.Diagnostics) (whitelabelValidationModel, diag.Diagnostics) {
+	var m whitelabelValidationModel
+	var diags diag.Diagnostics
+	diags.Append(get(ctx, path.Root("id"), &m.ID)...)
+	diags.Append(get(ctx, path.Root(r.targetAttr), &m.TargetID)...)
+	diags.Append(get(ctx, path.Root("triggers"), &m.Triggers)...)
+	diags.Append(get(ctx, path.Root("require_valid"), &m.RequireValid)...)
+	diags.Append(get(ctx, path.Root("wait_timeout"), &m.WaitTimeout)...)
+	diags.Append(get(ctx, path.Root("valid"), &m.Valid)...)
+	diags.Append(get(ctx, path.Root("reason"), &m.Reason)...)
+	diags.Append(get(ctx, path.Root("validation_results"), &m.ValidationResults)...)
+	return m, diags
+}
+
+// setModel writes m into state via SetAttribute.
+func (r *whitelabelValidationResource) setModel(ctx context.Context, set func(context.Context, path.Path, any) diag.Diagnostics, m whitelabelValidationModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.Append(set(ctx, path.Root("id"), m.ID)...)
+	diags.Append(set(ctx, path.Root(r.targetAttr), m.TargetID)...)
+	diags.Append(set(ctx, path.Root("triggers"), m.Triggers)...)
+	diags.Append(set(ctx, path.Root("require_valid"), m.RequireValid)...)
+	diags.Append(set(ctx, path.Root("wait_timeout"), m.WaitTimeout)...)
+	diags.Append(set(ctx, path.Root("valid"), m.Valid)...)
+	diags.Append(set(ctx, path.Root("reason"), m.Reason)...)
+	diags.Append(set(ctx, path.Root("validation_results"), m.ValidationResults)...)
+	return diags
+}
+
+// Create runs validation, retrying until it passes when wait_timeout is set.
+// POST {apiPath}/{id}/validate
+func (r *whitelabelValidationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Not configured", "Provider configuration is missing")
+		return
+	}
+
+	plan, diags := r.getModel(ctx, req.Plan.GetAttribute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var timeout time.Duration
+	if !plan.WaitTimeout.IsNull() && !plan.WaitTimeout.IsUnknown() {
+		t, err := time.ParseDuration(plan.WaitTimeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("wait_timeout"), "Invalid wait_timeout", err.Error())
+			return
+		}
+		timeout = t
+	}
+
+	result, diags := r.waitForValid(ctx, plan.TargetID.ValueString(), timeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.TargetID
+	plan.Valid = types.BoolValue(result.Valid)
+	plan.Reason = types.StringNull()
+	plan.ValidationResults = validationResultsToMap(ctx, result, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !result.Valid {
+		summary := fmt.Sprintf("Validation of %s %s did not pass", r.targetLabel, plan.TargetID.ValueString())
+		if timeout > 0 {
+			summary += fmt.Sprintf(" within %s", timeout)
+		}
+		detail := failedValidationRecords(result)
+		plan.Reason = stringOrNull(detail)
+		if plan.RequireValid.ValueBool() {
+			resp.Diagnostics.AddError(summary, detail)
+			return
+		}
+		resp.Diagnostics.AddWarning(summary, detail)
+	}
+
+	resp.Diagnostics.Append(r.setModel(ctx, resp.State.SetAttribute, plan)...)
+}
+
+// Read keeps the recorded results; validation only runs on create.
+func (r *whitelabelValidationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state, diags := r.getModel(ctx, req.State.GetAttribute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.setModel(ctx, resp.State.SetAttribute, state)...)
+}
+
+// Update is never called with a diff, since every argument forces replacement.
+func (r *whitelabelValidationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan, diags := r.getModel(ctx, req.Plan.GetAttribute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.setModel(ctx, resp.State.SetAttribute, plan)...)
+}
+
+// Delete only removes the resource from state.
+func (r *whitelabelValidationResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// waitForValid calls validate until it passes or timeout has elapsed, and
+// returns the last result. A zero timeout makes a single attempt.
+func (r *whitelabelValidationResource) waitForValid(ctx context.Context, id string, timeout time.Duration) (whitelabelValidationResponse, diag.Diagnostics) {
+	deadline := time.Now().Add(timeout)
+	for {
+		result, diags := r.validate(ctx, id)
+		if diags.HasError() || result.Valid {
+			return result, diags
+		}
+		tflog.Debug(ctx, "Validation did not pass yet", map[string]any{"id": id, "failing": failedValidationRecords(result)})
+		if time.Now().Add(whitelabelValidationPollInterval).After(deadline) {
+			return result, diags
+		}
+		select {
+		case <-ctx.Done():
+			diags.AddError("Canceled waiting for validation", ctx.Err().Error())
+			return result, diags
+		case <-time.After(whitelabelValidationPollInterval):
+		}
+	}
+}
+
+// validate calls the validate endpoint once and decodes its result.
+func (r *whitelabelValidationResource) validate(ctx context.Context, id string) (whitelabelValidationResponse, diag.Diagnostics) {
+	var out whitelabelValidationResponse
+
+	diags := r.client.requireScopes(ctx, "validate "+r.targetLabel, "whitelabel.update")
+	if diags.HasError() {
+		return out, diags
+	}
+
+	p := r.apiPath + "/" + url.PathEscape(id) + "/validate"
+	reqSG := sendgrid.GetRequest(r.client.APIKey, p, r.client.BaseURL)
+	reqSG.Method = "POST"
+
+	tflog.Debug(ctx, "POST "+p)
+
+	sgResp, err := sendgrid.API(reqSG)
+	r.client.invalidateGetCache()
+	if err != nil {
+		diags.AddError("SendGrid API error", err.Error())
+		return out, diags
+	}
+	diags.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+	if sgResp.StatusCode >= 300 {
+		diags.AddError(
+			fmt.Sprintf("Validate %s failed: %s", r.targetLabel, apiErrorMessage(sgResp.Body)),
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+		return out, diags
+	}
+	if err := json.Unmarshal([]byte(sgResp.Body), &out); err != nil {
+		diags.AddError("Parse error (validate)", fmt.Sprintf("unable to parse body: %v", err))
+	}
+	return out, diags
+}
+
+func validationResultsToMap(ctx context.Context, result whitelabelValidationResponse, diags *diag.Diagnostics) types.Map {
+	elems := make(map[string]attr.Value, len(result.ValidationResults))
+	for name, res := range result.ValidationResults {
+		reason := types.StringNull()
+		if res.Reason != nil && *res.Reason != "" {
+			reason = types.StringValue(*res.Reason)
+		}
+		obj, d := types.ObjectValue(validationResultObjectType().AttrTypes, map[string]attr.Value{
+			"valid":  types.BoolValue(res.Valid),
+			"reason": reason,
+		})
+		diags.Append(d...)
+		elems[name] = obj
+	}
+	out, d := types.MapValue(validationResultObjectType(), elems)
+	diags.Append(d...)
+	return out
+}
+
+// failedValidationRecords lists the failing records and their reasons, one
+// per line, in a stable order.
+func failedValidationRecords(result whitelabelValidationResponse) string {
+	var lines []string
+	for name, res := range result.ValidationResults {
+		if res.Valid {
+			continue
+		}
+		reason := "no reason given"
+		if res.Reason != nil && *res.Reason != "" {
+			reason = *res.Reason
+		}
+		lines = append(lines, fmt.Sprintf("%s: %s", name, reason))
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWhitelabelValidation_ValidateAndFailures(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v3/whitelabel/domains/42/validate" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":42,"valid":false,"validation_results":{
+			"mail_cname":{"valid":true,"reason":null},
+			"dkim1":{"valid":false,"reason":"Expected CNAME to match \"s1.domainkey.u1.wl.sendgrid.net.\""},
+			"spf":{"valid":false,"reason":null}}}`))
+	}))
+	defer srv.Close()
+
+	r := NewDomainAuthenticationValidationResource().(*whitelabelValidationResource)
+	r.client = &Client{BaseURL: srv.URL, APIKey: "test-key", SkipScopePreflight: true}
+
+	got, diags := r.validate(context.Background(), "42")
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if got.Valid || len(got.ValidationResults) != 3 {
+		t.Fatalf("unexpected result: %+v", got)
+	}
+	want := "dkim1: Expected CNAME to match \"s1.domainkey.u1.wl.sendgrid.net.\"\nspf: no reason given"
+	if msg := failedValidationRecords(got); msg != want {
+		t.Fatalf("failedValidationRecords = %q, want %q", msg, want)
+	}
+
+	m := validationResultsToMap(context.Background(), got, &diags)
+	if diags.HasError() || len(m.Elements()) != 3 {
+		t.Fatalf("validationResultsToMap: %v %v", m, diags)
+	}
+}
+
+func TestWhitelabelValidation_WaitForValid(t *testing.T) {
+	old := whitelabelValidationPollInterval
+	whitelabelValidationPollInterval = time.Millisecond
+	defer func() { whitelabelValidationPollInterval = old }()
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			_, _ = w.Write([]byte(`{"id":42,"valid":false,"validation_results":{"dkim1":{"valid":false,"reason":"no record"}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":42,"valid":true,"validation_results":{"dkim1":{"valid":true,"reason":null}}}`))
+	}))
+	defer srv.Close()
+
+	r := NewDomainAuthenticationValidationResource().(*whitelabelValidationResource)
+	r.client = &Client{BaseURL: srv.URL, APIKey: "test-key", SkipScopePreflight: true}
+
+	got, diags := r.waitForValid(context.Background(), "42", 0)
+	if diags.HasError() || got.Valid || calls.Load() != 1 {
+		t.Fatalf("zero timeout: valid=%v calls=%d diags=%v", got.Valid, calls.Load(), diags)
+	}
+
+	got, diags = r.waitForValid(context.Background(), "42", time.Minute)
+	if diags.HasError() || !got.Valid || calls.Load() != 3 {
+		t.Fatalf("with timeout: valid=%v calls=%d diags=%v", got.Valid, calls.Load(), diags)
+	}
+}
+
+func TestWhitelabelValidation_ReverseDNS(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v3/whitelabel/ips/7/validate" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":7,"valid":false,"validation_results":{
+			"a_record":{"valid":false,"reason":"Expected a A record for o1.email.example.com pointing to 192.0.2.10"}}}`))
+	}))
+	defer srv.Close()
+
+	r := NewReverseDNSValidationResource().(*whitelabelValidationResource)
+	r.client = &Client{BaseURL: srv.URL, APIKey: "test-key", SkipScopePreflight: true}
+
+	got, diags := r.validate(context.Background(), "7")
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	want := "a_record: Expected a A record for o1.email.example.com pointing to 192.0.2.10"
+	if got.Valid || failedValidationRecords(got) != want {
+		t.Fatalf("unexpected result: %+v", got)
+	}
+}
@@ -0,0 +1,322 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// NOTE: Scope catalog and validation shared by every resource that accepts
+// permission scopes (sso_teammate today, teammate and api_key later), so scope
+// lists behave identically wherever they appear:
+//   - scopeSetValidator: schema-level syntax check of each scope name.
+//   - checkScopeSets: plan-time check against the catalog (known scope names).
+//   - dropImpliedScopes: ignores the scopes SendGrid adds on its own (2fa_*
+//     and the .read sibling of .create/.update/.delete) when reading back.
+// The same catalog backs requireScopes, the pre-mutation permission check.
+//
+// API Endpoints:
+//   - List scopes: GET /v3/scopes
+//
+// API Documentation:
+//   - Retrieve scopes: https://www.twilio.com/docs/sendgrid/api-reference/api-key-permissions/retrieve-a-list-of-scopes-for-which-this-user-has-access
+
+type scopesResponse struct {
+	Scopes []string `json:"scopes"`
+}
+
+// scopeCatalog returns the set of scopes available to the provider API key.
+// The result (including a failure) is fetched once per provider instance and
+// shared by all resources.
+func (c *Client) scopeCatalog(ctx context.Context) (map[string]struct{}, diag.Diagnostics) {
+	c.scopesOnce.Do(func() {
+		c.scopes, c.scopesDiags = c.fetchScopeCatalog(ctx)
+	})
+	return c.scopes, c.scopesDiags
+}
+
+func (c *Client) fetchScopeCatalog(ctx context.Context) (map[string]struct{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tflog.Debug(ctx, "GET /v3/scopes")
+	sgResp, err := c.cachedGet(ctx, "/v3/scopes", nil)
+	if err != nil {
+		diags.AddError("SendGrid API error (scopes)", err.Error())
+		return nil, diags
+	}
+	diags.Append(deprecationWarnings("GET", "/v3/scopes", sgResp.Headers)...)
+	if sgResp.StatusCode >= 300 {
+		diags.AddError("Read scopes failed",
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+		return nil, diags
+	}
+
+	var got scopesResponse
+	if err := json.Unmarshal([]byte(sgResp.Body), &got); err != nil {
+		diags.AddError("Parse error (scopes)", fmt.Sprintf("unable to parse body: %v", err))
+		return nil, diags
+	}
+	catalog := make(map[string]struct{}, len(got.Scopes))
+	for _, s := range got.Scopes {
+		catalog[s] = struct{}{}
+	}
+	return catalog, diags
+}
+
+// validateScopes adds an attribute error at p for every scope that is not in
+// the catalog, naming the offending scope. Empty strings (unknown elements of
+// a partially known set) are skipped.
+func validateScopes(catalog map[string]struct{}, p path.Path, scopes []string, diags *diag.Diagnostics) {
+	for _, s := range scopes {
+		if s == "" {
+			continue
+		}
+		if _, ok := catalog[s]; !ok {
+			diags.AddAttributeError(p, "Unknown scope",
+				fmt.Sprintf("%q is not in the scope catalog returned by GET /v3/scopes. Check the scope name for typos, or that the provider API key itself holds this scope.", s))
+		}
+	}
+}
+
+// scopeSetAtPath is one configured scope set and where it appears.
+type scopeSetAtPath struct {
+	Path   path.Path
+	Scopes types.Set
+}
+
+// checkScopeSets validates every known scope in sets against the scope catalog
+// so an invalid scope fails the plan with the scope named instead of a 400 at
+// apply. Null and unknown sets and elements are skipped. When the catalog
+// cannot be read (e.g., the API key lacks access to /v3/scopes) a warning is
+// added instead, and the API still validates at apply.
+func (c *Client) checkScopeSets(ctx context.Context, sets []scopeSetAtPath, diags *diag.Diagnostics) {
+	type known struct {
+		path   path.Path
+		scopes []string
+	}
+	var lists []known
+	for _, s := range sets {
+		if s.Scopes.IsNull() || s.Scopes.IsUnknown() {
+			continue
+		}
+		var scopes []string
+		diags.Append(s.Scopes.ElementsAs(ctx, &scopes, true)...)
+		if diags.HasError() {
+			return
+		}
+		if len(scopes) > 0 {
+			lists = append(lists, known{s.Path, scopes})
+		}
+	}
+	if len(lists) == 0 {
+		return
+	}
+
+	catalog, catalogDiags := c.scopeCatalog(ctx)
+	if catalogDiags.HasError() {
+		diags.AddWarning("Scope validation skipped",
+			fmt.Sprintf("Unable to read the scope catalog: %s", catalogDiags[0].Detail()))
+		return
+	}
+	for _, l := range lists {
+		validateScopes(catalog, l.path, l.scopes, diags)
+	}
+}
+
+// scopeSetValidator rejects scope names that cannot be valid (empty, or with
+// whitespace or empty dot-separated segments) without calling the API.
+type scopeSetValidator struct{}
+
+var _ validator.Set = scopeSetValidator{}
+
+func (v scopeSetValidator) Description(_ context.Context) string {
+	return "each scope must be a dot-separated scope name such as `mail.send`"
+}
+
+func (v scopeSetValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v scopeSetValidator) ValidateSet(_ context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	for _, e := range req.ConfigValue.Elements() {
+		s, ok := e.(types.String)
+		if !ok || s.IsNull() || s.IsUnknown() {
+			continue
+		}
+		if !validScopeName(s.ValueString()) {
+			resp.Diagnostics.AddAttributeError(req.Path.AtSetValue(s), "Invalid scope",
+				fmt.Sprintf("%q is not a valid scope name; scopes are dot-separated names such as \"mail.send\" or \"templates.read\".", s.ValueString()))
+		}
+	}
+}
+
+func validScopeName(s string) bool {
+	if s == "" || strings.ContainsFunc(s, unicode.IsSpace) {
+		return false
+	}
+	for _, part := range strings.Split(s, ".") {
+		if part == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// requireScopes checks, before a mutation, that the provider API key holds
+// every scope in required, and returns an error naming the missing ones for
+// action (e.g. "create subuser"). The check uses the cached scope catalog; if
+// the catalog cannot be read, or skip_scope_preflight is set, it is skipped and
+// the API call itself reports any permission problem.
+//
+// A scope only counts as missing when the key holds another scope of the same
+// family (e.g. mc.segments.read for mc.segments.update), which shows that the
+// name is one SendGrid knows. Otherwise the name cannot be told apart from a
+// scope the provider names wrongly, so a warning is added and the call goes
+// ahead.
+func (c *Client) requireScopes(ctx context.Context, action string, required ...string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if c.SkipScopePreflight {
+		return diags
+	}
+	catalog, catalogDiags := c.scopeCatalog(ctx)
+	if catalogDiags.HasError() {
+		tflog.Debug(ctx, "Skipping scope preflight: scope catalog unavailable", map[string]any{"action": action})
+		return diags
+	}
+	var missing, unverified []string
+	for _, s := range required {
+		if _, ok := catalog[s]; ok {
+			continue
+		}
+		if holdsScopeFamily(catalog, s) {
+			missing = append(missing, s)
+		} else {
+			unverified = append(unverified, s)
+		}
+	}
+	if len(missing) > 0 {
+		diags.AddError("API key is missing a required scope",
+			fmt.Sprintf("Cannot %s: the provider API key does not hold %s (checked via GET /v3/scopes). Grant the scope to the key, or set skip_scope_preflight = true in the provider to bypass this check.",
+				action, strings.Join(quoteAll(missing), ", ")))
+	}
+	if len(unverified) > 0 {
+		diags.AddWarning("Required scope not verified",
+			fmt.Sprintf("Before the request to %s, GET /v3/scopes listed neither %s nor any other scope of the same family, so the scope could not be checked. The request is sent anyway; SendGrid rejects it if the API key lacks the permission.",
+				action, strings.Join(quoteAll(unverified), ", ")))
+	}
+	return diags
+}
+
+// holdsScopeFamily reports whether catalog holds a scope that shares scope's
+// parent (the name up to its last dot), e.g. subusers.read for subusers.delete.
+func holdsScopeFamily(catalog map[string]struct{}, scope string) bool {
+	i := strings.LastIndex(scope, ".")
+	if i < 0 {
+		return false
+	}
+	prefix := scope[:i+1]
+	for s := range catalog {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func quoteAll(ss []string) []string {
+	out := make([]string, len(ss))
+	for i, s := range ss {
+		out[i] = strconv.Quote(s)
+	}
+	return out
+}
+
+// impliedScopes are added by SendGrid to any scope set it stores, whether or
+// not they were requested.
+var impliedScopes = map[string]struct{}{
+	"2fa_exempt":   {},
+	"2fa_required": {},
+}
+
+// impliedReadSiblings are the verbs for which SendGrid also grants the
+// matching `.read` scope (e.g., `templates.create` implies `templates.read`).
+var impliedReadSiblings = []string{".create", ".update", ".delete"}
+
+// dropImpliedScopes removes scopes that SendGrid adds on its own (see
+// impliedScopes and impliedReadSiblings) unless prior lists them explicitly,
+// so remote values compare equal to the configured set.
+func dropImpliedScopes(ctx context.Context, remote []string, prior types.Set) []string {
+	explicit := map[string]struct{}{}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var known []string
+		if d := prior.ElementsAs(ctx, &known, false); !d.HasError() {
+			for _, s := range known {
+				explicit[s] = struct{}{}
+			}
+		}
+	}
+	present := make(map[string]struct{}, len(remote))
+	for _, s := range remote {
+		present[s] = struct{}{}
+	}
+
+	out := make([]string, 0, len(remote))
+	for _, s := range remote {
+		if _, ok := explicit[s]; ok || !isImpliedScope(s, present) {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func isImpliedScope(scope string, present map[string]struct{}) bool {
+	if _, ok := impliedScopes[scope]; ok {
+		return true
+	}
+	base, ok := strings.CutSuffix(scope, ".read")
+	if !ok {
+		return false
+	}
+	for _, verb := range impliedReadSiblings {
+		if _, ok := present[base+verb]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// dropImpliedSubuserScopes applies dropImpliedScopes to each subuser_access
+// entry, using the prior entry with the same subuser ID (if any).
+func dropImpliedSubuserScopes(ctx context.Context, entries []subuserAccessEntry, prior types.Set) []subuserAccessEntry {
+	priorScopes := map[string]types.Set{}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var objs []subuserAccessObject
+		if d := prior.ElementsAs(ctx, &objs, false); !d.HasError() {
+			for _, o := range objs {
+				priorScopes[o.ID.ValueString()] = o.Scopes
+			}
+		}
+	}
+	out := make([]subuserAccessEntry, 0, len(entries))
+	for _, e := range entries {
+		ps, ok := priorScopes[strconv.FormatInt(e.ID, 10)]
+		if !ok {
+			ps = types.SetNull(types.StringType)
+		}
+		e.Scopes = dropImpliedScopes(ctx, e.Scopes, ps)
+		out = append(out, e)
+	}
+	return out
+}
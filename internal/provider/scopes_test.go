@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestScopeCatalog_CachedPerClient(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/scopes" {
+			http.NotFound(w, r)
+			return
+		}
+		atomic.AddInt32(&calls, 1)
+		_, _ = w.Write([]byte(`{"scopes":["stats.read","messages.read"]}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, APIKey: "test-key"}
+	for i := 0; i < 3; i++ {
+		catalog, diags := c.scopeCatalog(context.Background())
+		if diags.HasError() {
+			t.Fatalf("unexpected diags: %v", diags)
+		}
+		if _, ok := catalog["stats.read"]; !ok {
+			t.Fatalf("catalog missing stats.read: %v", catalog)
+		}
+	}
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Fatalf("GET /v3/scopes called %d times, want 1", n)
+	}
+}
+
+func TestValidateScopes_NamesUnknownScope(t *testing.T) {
+	catalog := map[string]struct{}{"stats.read": {}}
+	var diags diag.Diagnostics
+	validateScopes(catalog, path.Root("scopes"), []string{"stats.read", "stats.raed"}, &diags)
+
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("expected exactly one error, got %v", diags)
+	}
+	if !strings.Contains(diags[0].Detail(), `"stats.raed"`) {
+		t.Fatalf("error should name the invalid scope: %s", diags[0].Detail())
+	}
+}
+
+func TestDropImpliedScopes(t *testing.T) {
+	ctx := context.Background()
+	remote := []string{"2fa_exempt", "templates.create", "templates.read", "stats.read"}
+
+	// 2fa_exempt and the templates.read sidecar are dropped; stats.read has no
+	// write sibling so it is kept.
+	got := dropImpliedScopes(ctx, remote, scopesSliceToSet([]string{"templates.create", "stats.read"}))
+	if want := []string{"templates.create", "stats.read"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	// An implied scope that was configured explicitly stays.
+	got = dropImpliedScopes(ctx, remote, scopesSliceToSet([]string{"templates.create", "templates.read", "stats.read"}))
+	if want := []string{"templates.create", "templates.read", "stats.read"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestRequireScopes(t *testing.T) {
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"scopes":["subusers.create","subusers.read"]}`))
+	}))
+	defer srv.Close()
+	ctx := context.Background()
+
+	c := &Client{BaseURL: srv.URL, APIKey: "test-key"}
+	if diags := c.requireScopes(ctx, "create subuser", "subusers.create"); diags.HasError() {
+		t.Fatalf("held scope reported missing: %v", diags)
+	}
+	diags := c.requireScopes(ctx, "delete subuser", "subusers.delete")
+	if !diags.HasError() || !strings.Contains(diags[0].Detail(), `"subusers.delete"`) || !strings.Contains(diags[0].Detail(), "delete subuser") {
+		t.Fatalf("expected an error naming the action and missing scope, got %v", diags)
+	}
+
+	// Nothing of the mc.segments family is held, so a misspelt name cannot be
+	// ruled out: warn and let the request through.
+	diags = c.requireScopes(ctx, "refresh segment", "mc.segments.update")
+	if diags.HasError() || diags.WarningsCount() != 1 || !strings.Contains(diags[0].Detail(), `"mc.segments.update"`) {
+		t.Fatalf("expected a single warning naming the unverified scope, got %v", diags)
+	}
+
+	c = &Client{BaseURL: srv.URL, APIKey: "test-key", SkipScopePreflight: true}
+	if diags := c.requireScopes(ctx, "delete subuser", "subusers.delete"); diags.HasError() {
+		t.Fatalf("skip_scope_preflight should bypass the check: %v", diags)
+	}
+
+	// An unreadable catalog must not block the mutation.
+	status = http.StatusForbidden
+	c = &Client{BaseURL: srv.URL, APIKey: "test-key"}
+	if diags := c.requireScopes(ctx, "delete subuser", "subusers.delete"); diags.HasError() {
+		t.Fatalf("catalog failure should skip the check: %v", diags)
+	}
+}
+
+func TestScopeSetValidator(t *testing.T) {
+	set, _ := types.SetValueFrom(context.Background(), types.StringType, []string{"mail.send", "2fa_exempt", "templates..read", "stats .read"})
+	req := validator.SetRequest{Path: path.Root("scopes"), ConfigValue: set}
+	var resp validator.SetResponse
+	scopeSetValidator{}.ValidateSet(context.Background(), req, &resp)
+
+	if resp.Diagnostics.ErrorsCount() != 2 {
+		t.Fatalf("expected two errors, got %v", resp.Diagnostics)
+	}
+	for _, d := range resp.Diagnostics {
+		if strings.HasPrefix(d.Detail(), `"mail.send"`) || strings.HasPrefix(d.Detail(), `"2fa_exempt"`) {
+			t.Errorf("valid scope reported: %s", d.Detail())
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sendgrid/sendgrid-go"
+)
+
+// NOTE: Request helpers for singleton settings: endpoints that hold one
+// settings object per account (or per subuser, via the on-behalf-of header)
+// and are read with GET and changed with PATCH, such as the mail settings,
+// the partner settings and the default domain authentication.
+//
+// Those resources share their id and on_behalf_of handling too: the id is the
+// subuser username, or parentAccountID for the parent account, and the same
+// values are accepted as import IDs.
+
+// parentAccountID is the id of a setting of the parent account.
+const parentAccountID = "account"
+
+// accountScopedID returns the resource id for a setting managed on behalf of
+// onBehalfOf (empty for the parent account).
+func accountScopedID(onBehalfOf string) string {
+	if onBehalfOf == "" {
+		return parentAccountID
+	}
+	return onBehalfOf
+}
+
+// accountScopedAttributes returns the id and on_behalf_of attributes shared
+// by the resources that manage one object per account or subuser.
+func accountScopedAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "`on_behalf_of`, or `" + parentAccountID + "` for the parent account.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"on_behalf_of": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Subuser username whose setting is managed, sent as the `on-behalf-of` header. Omit for the parent account. Changing it forces replacement.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+}
+
+// importAccountScopedState accepts `account` or a subuser username as import ID.
+func importAccountScopedState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		resp.Diagnostics.AddError("Invalid import ID",
+			fmt.Sprintf("expected %q or a subuser username, got an empty ID", parentAccountID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	if req.ID != parentAccountID {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("on_behalf_of"), req.ID)...)
+	}
+}
+
+// settingRequest GETs the singleton setting at p, or PATCHes it when payload
+// is non-nil, and decodes the response into out. what names the setting in
+// error messages.
+func (c *Client) settingRequest(ctx context.Context, p, what, onBehalfOf string, payload, out any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	reqSG := sendgrid.GetRequest(c.APIKey, p, c.BaseURL)
+	reqSG.Method = "GET"
+	if payload != nil {
+		b, _ := json.Marshal(payload)
+		reqSG.Method = "PATCH"
+		reqSG.Body = b
+	}
+	method := string(reqSG.Method)
+	if onBehalfOf != "" {
+		reqSG.Headers["on-behalf-of"] = onBehalfOf
+	}
+
+	tflog.Debug(ctx, method+" "+p, map[string]any{"on_behalf_of": onBehalfOf})
+
+	sgResp, err := sendgrid.API(reqSG)
+	if err != nil {
+		diags.AddError("SendGrid API error", err.Error())
+		return diags
+	}
+	diags.Append(deprecationWarnings(method, reqSG.BaseURL, sgResp.Headers)...)
+	if sgResp.StatusCode >= 300 {
+		diags.AddError(
+			fmt.Sprintf("%s %s failed: %s", method, what, apiErrorMessage(sgResp.Body)),
+			fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+		return diags
+	}
+	if err := json.Unmarshal([]byte(sgResp.Body), out); err != nil {
+		diags.AddError(fmt.Sprintf("Parse error (%s)", what), fmt.Sprintf("unable to parse body: %v", err))
+	}
+	return diags
+}
+
+// writeSetting is settingRequest for PATCHes, which invalidate the GET cache.
+func (c *Client) writeSetting(ctx context.Context, p, what, onBehalfOf string, payload, out any) diag.Diagnostics {
+	diags := c.settingRequest(ctx, p, what, onBehalfOf, payload, out)
+	c.invalidateGetCache()
+	return diags
+}
@@ -0,0 +1,12 @@
+package provider
+
+import "testing"
+
+func TestAccountScopedID(t *testing.T) {
+	if got := accountScopedID(""); got != parentAccountID {
+		t.Fatalf("parent account id = %q", got)
+	}
+	if got := accountScopedID("sub-1"); got != "sub-1" {
+		t.Fatalf("subuser id = %q", got)
+	}
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// NOTE: Subuser listing shared by resources that reference subusers (e.g.,
+// sso_teammate subuser_access validation). Pages go through the client's GET
+// cache, so one run lists them once unless a write happens in between.
+//
+// API Endpoints:
+//   - List subusers: GET /v3/subusers?limit={limit}&offset={offset}
+//
+// API Documentation:
+//   - List Subusers: https://www.twilio.com/docs/sendgrid/api-reference/subusers-api/list-all-subusers
+
+// subuserListPageSize is the limit requested per /v3/subusers page.
+const subuserListPageSize = 100
+
+// allSubusers returns every subuser of the account, walking offset/limit pages
+// until a short page is returned. Pages are served from the client's GET cache,
+// so multiple resources in one run share a single listing.
+func (c *Client) allSubusers(ctx context.Context) ([]subuserAPI, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var all []subuserAPI
+	for offset := 0; ; offset += subuserListPageSize {
+		tflog.Debug(ctx, "GET /v3/subusers", map[string]any{"offset": offset})
+		sgResp, err := c.cachedGet(ctx, "/v3/subusers", map[string]string{
+			"limit":  strconv.Itoa(subuserListPageSize),
+			"offset": strconv.Itoa(offset),
+		})
+		if err != nil {
+			diags.AddError("SendGrid API error (subusers)", err.Error())
+			return nil, diags
+		}
+		diags.Append(deprecationWarnings("GET", "/v3/subusers", sgResp.Headers)...)
+		if sgResp.StatusCode >= 300 {
+			diags.AddError("List subusers failed",
+				fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+			return nil, diags
+		}
+		var page []subuserAPI
+		if err := json.Unmarshal([]byte(sgResp.Body), &page); err != nil {
+			diags.AddError("Parse error (subusers)", fmt.Sprintf("unable to parse body: %v", err))
+			return nil, diags
+		}
+		all = append(all, page...)
+		if len(page) < subuserListPageSize {
+			break
+		}
+	}
+	return all, diags
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestAllSubusers_WalksOffsetPages(t *testing.T) {
+	const total = subuserListPageSize + 5
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		page := []subuserAPI{}
+		for i := offset; i < total && i < offset+limit; i++ {
+			page = append(page, subuserAPI{ID: int64(i + 1), Username: "su" + strconv.Itoa(i+1)})
+		}
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, APIKey: "test-key"}
+	got, diags := c.allSubusers(context.Background())
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if len(got) != total {
+		t.Fatalf("got %d subusers, want %d", len(got), total)
+	}
+
+	// Second call is served from the client cache.
+	_, _ = c.allSubusers(context.Background())
+	if calls != 2 {
+		t.Fatalf("GET /v3/subusers called %d times, want 2", calls)
+	}
+}
+
+func TestFilterSubusersByUsername(t *testing.T) {
+	items := []subuserAPI{{Username: "prod-a"}, {Username: "prod-b2"}, {Username: "stg-a"}}
+
+	got := filterSubusersByUsername(items, "prod-", nil)
+	if len(got) != 2 {
+		t.Fatalf("prefix filter: got %+v", got)
+	}
+	got = filterSubusersByUsername(items, "prod-", regexp.MustCompile(`\d$`))
+	if len(got) != 1 || got[0].Username != "prod-b2" {
+		t.Fatalf("prefix+regex filter: got %+v", got)
+	}
+}
+
+func TestFilterSubusersByDisabled(t *testing.T) {
+	items := []subuserAPI{{Username: "a"}, {Username: "b", Disabled: true}, {Username: "c"}}
+
+	if got := filterSubusersByDisabled(items, "false"); len(got) != 2 || got[1].Username != "c" {
+		t.Fatalf("active only: got %+v", got)
+	}
+	if got := filterSubusersByDisabled(items, "true"); len(got) != 1 || got[0].Username != "b" {
+		t.Fatalf("disabled only: got %+v", got)
+	}
+	if got := filterSubusersByDisabled(items, "any"); len(got) != 3 {
+		t.Fatalf("any: got %+v", got)
+	}
+}
+
+func TestSortSubusers(t *testing.T) {
+	items := []subuserAPI{{ID: 2, Username: "b"}, {ID: 3, Username: "a"}, {ID: 1, Username: "c"}}
+
+	sortSubusers(items, "username", false)
+	if items[0].Username != "a" || items[2].Username != "c" {
+		t.Fatalf("username asc: got %+v", items)
+	}
+	sortSubusers(items, "id", true)
+	if items[0].ID != 3 || items[2].ID != 1 {
+		t.Fatalf("id desc: got %+v", items)
+	}
+}
+
+func TestSubusersDataSourceFetchPage_UsesProviderClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/subusers" || r.URL.Query().Get("username") != "alpha" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		if r.Header.Get("Authorization") != "Bearer test-key" {
+			t.Errorf("missing API key, got %q", r.Header.Get("Authorization"))
+		}
+		_ = json.NewEncoder(w).Encode([]subuserAPI{{ID: 1, Username: "alpha"}})
+	}))
+	defer srv.Close()
+
+	d := &subusersDataSource{client: &Client{BaseURL: srv.URL, APIKey: "test-key"}}
+	got, diags := d.fetchPage(context.Background(), url.Values{"username": {"alpha"}})
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if len(got) != 1 || got[0].ID != 1 {
+		t.Fatalf("unexpected result: %+v", got)
+	}
+}
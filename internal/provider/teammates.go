@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sendgrid/sendgrid-go"
+)
+
+// NOTE: Teammate subuser access listing shared by the sso_teammate resource
+// and the teammate data sources, and the pending invitation lookup used by the
+// teammate data source.
+//
+// API Endpoints:
+//   - Subuser Access: GET /v3/teammates/{username}/subuser_access (paginated)
+//   - Pending:        GET /v3/teammates/pending
+//
+// API Documentation:
+//   - Teammate Subuser Access: https://www.twilio.com/docs/sendgrid/api-reference/teammates/retrieve-teammate-subuser-access
+//   - Retrieve all pending teammates: https://www.twilio.com/docs/sendgrid/api-reference/teammates/retrieve-all-pending-teammates
+
+type teammateSubuserAccessResponse struct {
+	HasRestrictedSubuserAccess bool `json:"has_restricted_subuser_access"`
+	SubuserAccess              []struct {
+		ID             int64    `json:"id"`
+		Username       string   `json:"username"`
+		Email          string   `json:"email"`
+		Disabled       bool     `json:"disabled"`
+		PermissionType string   `json:"permission_type"`
+		Scopes         []string `json:"scopes"`
+	} `json:"subuser_access"`
+	Metadata struct {
+		NextParams struct {
+			Limit          int64  `json:"limit"`
+			AfterSubuserID int64  `json:"after_subuser_id"`
+			Username       string `json:"username"`
+		} `json:"next_params"`
+	} `json:"_metadata"`
+}
+
+// teammateSubuserAccess fetches every page of a teammate's subuser access,
+// requesting pageSize entries per page and following
+// _metadata.next_params.after_subuser_id until it returns 0. onBehalfOf, when
+// non-empty, is sent as the `on-behalf-of` header.
+// Returns (has_restricted_subuser_access, entries, diags).
+func (c *Client) teammateSubuserAccess(ctx context.Context, username, onBehalfOf string, pageSize int64) (bool, []subuserAccessEntry, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var allEntries []subuserAccessEntry
+	var hasRestricted bool
+	var afterID int64 = 0
+	for {
+		restricted, entries, next, pageDiags := c.teammateSubuserAccessPage(ctx, username, onBehalfOf, pageSize, afterID)
+		diags.Append(pageDiags...)
+		if diags.HasError() {
+			return false, nil, diags
+		}
+		hasRestricted = restricted
+		allEntries = append(allEntries, entries...)
+		if next == 0 {
+			break
+		}
+		afterID = next
+	}
+	return hasRestricted, allEntries, diags
+}
+
+// teammateSubuserAccessPage fetches the page of a teammate's subuser access
+// that follows afterID (0 for the first page). next is the after_subuser_id
+// of the following page, or 0 when this is the last one.
+func (c *Client) teammateSubuserAccessPage(ctx context.Context, username, onBehalfOf string, pageSize, afterID int64) (hasRestricted bool, entries []subuserAccessEntry, next int64, diags diag.Diagnostics) {
+	tflog.Debug(ctx, "GET /v3/teammates/{username}/subuser_access", map[string]any{"username": username, "after_subuser_id": afterID})
+	reqSA := sendgrid.GetRequest(c.APIKey, "/v3/teammates/"+username+"/subuser_access", c.BaseURL)
+	reqSA.Method = "GET"
+	if onBehalfOf != "" {
+		reqSA.Headers["on-behalf-of"] = onBehalfOf
+	}
+	if reqSA.QueryParams == nil {
+		reqSA.QueryParams = make(map[string]string)
+	}
+	reqSA.QueryParams["limit"] = strconv.FormatInt(pageSize, 10)
+	if afterID > 0 {
+		reqSA.QueryParams["after_subuser_id"] = strconv.FormatInt(afterID, 10)
+	}
+	saResp, err := sendgrid.API(reqSA)
+	if err != nil {
+		diags.AddError("SendGrid API error (subuser_access)", err.Error())
+		return false, nil, 0, diags
+	}
+	diags.Append(deprecationWarnings(string(reqSA.Method), reqSA.BaseURL, saResp.Headers)...)
+	if saResp.StatusCode >= 300 {
+		diags.AddError("Read subuser access failed", fmt.Sprintf("status=%d body=%s", saResp.StatusCode, saResp.Body))
+		return false, nil, 0, diags
+	}
+	var sa teammateSubuserAccessResponse
+	if err := json.Unmarshal([]byte(saResp.Body), &sa); err != nil {
+		diags.AddError("Parse error (subuser_access)", fmt.Sprintf("unable to parse body: %v", err))
+		return false, nil, 0, diags
+	}
+	for _, e := range sa.SubuserAccess {
+		entries = append(entries, subuserAccessEntry{
+			ID:             e.ID,
+			PermissionType: e.PermissionType,
+			Scopes:         e.Scopes,
+			Username:       e.Username,
+			Email:          e.Email,
+			Disabled:       e.Disabled,
+		})
+	}
+	return sa.HasRestrictedSubuserAccess, entries, sa.Metadata.NextParams.AfterSubuserID, diags
+}
+
+// pendingTeammateInvite is one element of GET /v3/teammates/pending.
+type pendingTeammateInvite struct {
+	Email          string   `json:"email"`
+	Scopes         []string `json:"scopes"`
+	IsAdmin        bool     `json:"is_admin"`
+	Token          string   `json:"token"`
+	ExpirationDate int64    `json:"expiration_date"` // Unix seconds
+}
+
+// findPendingTeammateInvite returns the pending invitation sent to email
+// (compared case-insensitively). onBehalfOf, when non-empty, is sent as the
+// `on-behalf-of` header. found is false when there is no such invitation.
+func (c *Client) findPendingTeammateInvite(ctx context.Context, email, onBehalfOf string) (pendingTeammateInvite, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tflog.Debug(ctx, "GET /v3/teammates/pending", map[string]any{"email": email})
+	reqSG := sendgrid.GetRequest(c.APIKey, "/v3/teammates/pending", c.BaseURL)
+	reqSG.Method = "GET"
+	if onBehalfOf != "" {
+		reqSG.Headers["on-behalf-of"] = onBehalfOf
+	}
+	sgResp, err := sendgrid.API(reqSG)
+	if err != nil {
+		diags.AddError("SendGrid API error (pending teammates)", err.Error())
+		return pendingTeammateInvite{}, false, diags
+	}
+	diags.Append(deprecationWarnings(string(reqSG.Method), reqSG.BaseURL, sgResp.Headers)...)
+	if sgResp.StatusCode >= 300 {
+		diags.AddError("Read pending teammates failed", fmt.Sprintf("status=%d body=%s", sgResp.StatusCode, sgResp.Body))
+		return pendingTeammateInvite{}, false, diags
+	}
+	var got struct {
+		Result []pendingTeammateInvite `json:"result"`
+	}
+	if err := json.Unmarshal([]byte(sgResp.Body), &got); err != nil {
+		diags.AddError("Parse error (pending teammates)", fmt.Sprintf("unable to parse body: %v", err))
+		return pendingTeammateInvite{}, false, diags
+	}
+	for _, inv := range got.Result {
+		if strings.EqualFold(inv.Email, email) {
+			return inv, true, diags
+		}
+	}
+	return pendingTeammateInvite{}, false, diags
+}
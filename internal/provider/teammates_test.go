@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTeammateSubuserAccess_FollowsCursorWithOnBehalfOf(t *testing.T) {
+	srv := newSubuserAccessServer(t, false, []int64{11, 22, 33})
+	defer srv.Close()
+	inner := srv.Config.Handler
+	var headers []string
+	srv.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Get("on-behalf-of"))
+		inner.ServeHTTP(w, r)
+	})
+
+	c := &Client{BaseURL: srv.URL, APIKey: "test-key"}
+	hasRestricted, entries, diags := c.teammateSubuserAccess(context.Background(), "someone@example.com", "parent-sub", defaultSubuserAccessPageSize)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if !hasRestricted || len(entries) != 3 || entries[0].Username != "sub11" {
+		t.Fatalf("unexpected result: %v %+v", hasRestricted, entries)
+	}
+	for _, h := range headers {
+		if h != "parent-sub" {
+			t.Fatalf("on-behalf-of header not sent on every page: %v", headers)
+		}
+	}
+}
+
+func TestFilterSubuserAccess(t *testing.T) {
+	entries := []subuserAccessEntry{
+		{ID: 1, PermissionType: "restricted"},
+		{ID: 2, PermissionType: "restricted", Disabled: true},
+		{ID: 3, PermissionType: "admin"},
+	}
+	got := filterSubuserAccess(entries, "restricted", true)
+	if len(got) != 1 || got[0].ID != 1 {
+		t.Fatalf("unexpected result: %+v", got)
+	}
+	if got := filterSubuserAccess(entries, "", false); len(got) != 3 {
+		t.Fatalf("no filter should keep every entry, got %+v", got)
+	}
+}
+
+func TestFindPendingTeammateInvite(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3/teammates/pending" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"result": []map[string]any{
+			{"email": "other@example.com", "token": "t1"},
+			{"email": "Invited@Example.com", "token": "t2", "is_admin": false, "scopes": []string{"mail.send"}, "expiration_date": 1700000000},
+		}})
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, APIKey: "test-key"}
+	inv, found, diags := c.findPendingTeammateInvite(context.Background(), "invited@example.com", "")
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if !found || inv.Token != "t2" || inv.ExpirationDate != 1700000000 || len(inv.Scopes) != 1 {
+		t.Fatalf("unexpected result: %v %+v", found, inv)
+	}
+
+	if _, found, _ := c.findPendingTeammateInvite(context.Background(), "missing@example.com", ""); found {
+		t.Fatal("expected no invitation for an unknown email")
+	}
+}
@@ -0,0 +1,189 @@
+package testacc
+
+import (
+	"encoding/json"
+	"net/url"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/sendgrid/sendgrid-go"
+)
+
+// Fixtures provision the SendGrid objects acceptance tests depend on, so the
+// suite runs against any account with only TF_ACC and SENDGRID_API_KEY set.
+// Each fixture is created through the API when first requested by a test and
+// deleted by t.Cleanup when that test finishes. Setting the matching TEST_*
+// variable reuses an existing object instead (e.g., for accounts that cannot
+// create subusers), and nothing is deleted in that case.
+
+// SubuserFixture is a throwaway subuser.
+type SubuserFixture struct {
+	ID       string
+	Username string
+	Email    string
+}
+
+// Subuser returns a subuser for the test, from TEST_SUBUSER_ID and
+// TEST_SUBUSER_USERNAME when both are set, otherwise from NewSubuser.
+func Subuser(t *testing.T) SubuserFixture {
+	t.Helper()
+	TestAccPreCheck(t)
+	if id, username := os.Getenv("TEST_SUBUSER_ID"), os.Getenv("TEST_SUBUSER_USERNAME"); id != "" && username != "" {
+		return SubuserFixture{ID: id, Username: username}
+	}
+	return NewSubuser(t)
+}
+
+// NewSubuser always creates a subuser, for tests that need several.
+func NewSubuser(t *testing.T) SubuserFixture {
+	t.Helper()
+	TestAccPreCheck(t)
+
+	username := RandomName("tfacc")
+	email := username + "@example.com"
+	var created struct {
+		UserID int64 `json:"user_id"`
+	}
+	mustCall(t, "/v3/subusers", map[string]any{
+		"username": username,
+		"email":    email,
+		"password": RandomPassword(),
+		"ips":      []string{SubuserIP(t)},
+	}, &created)
+	t.Cleanup(func() { cleanup(t, "/v3/subusers/"+url.PathEscape(username)) })
+
+	return SubuserFixture{ID: strconv.FormatInt(created.UserID, 10), Username: username, Email: email}
+}
+
+// SubuserIP returns an IP address assigned to the parent account, as required
+// when creating a subuser: TEST_SUBUSER_IP, or the first assigned IP.
+func SubuserIP(t *testing.T) string {
+	t.Helper()
+	TestAccPreCheck(t)
+	if ip := os.Getenv("TEST_SUBUSER_IP"); ip != "" {
+		return ip
+	}
+	var ips []struct {
+		IP string `json:"ip"`
+	}
+	mustCall(t, "/v3/ips/assigned", nil, &ips)
+	if len(ips) == 0 {
+		t.Skip("the account has no assigned IPs to give a subuser; set TEST_SUBUSER_IP")
+	}
+	return ips[0].IP
+}
+
+// SSOTeammate returns the username (email) of an SSO teammate:
+// TEST_TEAMMATE_NAME when set, otherwise a new teammate with restricted read
+// access to the Subuser fixture. The account must have SSO enabled.
+func SSOTeammate(t *testing.T) string {
+	t.Helper()
+	TestAccPreCheck(t)
+	if name := os.Getenv("TEST_TEAMMATE_NAME"); name != "" {
+		return name
+	}
+
+	subuserID := Subuser(t).ID
+	id, err := strconv.ParseInt(subuserID, 10, 64)
+	if err != nil {
+		t.Fatalf("invalid subuser ID %q: %v", subuserID, err)
+	}
+	email := RandomName("tfacc-teammate") + "@example.com"
+	mustCall(t, "/v3/sso/teammates", map[string]any{
+		"email":                         email,
+		"first_name":                    "Terraform",
+		"last_name":                     "Fixture",
+		"is_admin":                      false,
+		"has_restricted_subuser_access": true,
+		"subuser_access": []map[string]any{{
+			"id":              id,
+			"permission_type": "restricted",
+			"scopes":          []string{"stats.read"},
+		}},
+	}, nil)
+	t.Cleanup(func() { cleanup(t, "/v3/teammates/"+url.PathEscape(email)) })
+
+	return email
+}
+
+// LegacyTemplate returns the ID of an empty legacy transactional template:
+// TEST_LEGACY_TEMPLATE_ID when set, otherwise newly created.
+func LegacyTemplate(t *testing.T) string {
+	t.Helper()
+	TestAccPreCheck(t)
+	if id := os.Getenv("TEST_LEGACY_TEMPLATE_ID"); id != "" {
+		return id
+	}
+
+	var created struct {
+		ID string `json:"id"`
+	}
+	mustCall(t, "/v3/templates", map[string]any{
+		"name":       RandomName("tfacc-template"),
+		"generation": "legacy",
+	}, &created)
+	t.Cleanup(func() { cleanup(t, "/v3/templates/"+url.PathEscape(created.ID)) })
+
+	return created.ID
+}
+
+// RandomName returns prefix followed by a random lowercase suffix.
+func RandomName(prefix string) string {
+	return prefix + "-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+}
+
+// RandomPassword returns a password that satisfies SendGrid's subuser rules.
+func RandomPassword() string {
+	return acctest.RandStringFromCharSet(16, acctest.CharSetAlphaNum) + "Aa1!"
+}
+
+func baseURL() string {
+	if v := os.Getenv("SENDGRID_BASE_URL"); v != "" {
+		return v
+	}
+	return "https://api.sendgrid.com"
+}
+
+// mustCall sends a request with the test API key, as a POST with body when
+// body is non-nil and a GET otherwise, and fails the test on any error. The
+// response is decoded into out when it is non-nil.
+func mustCall(t *testing.T, path string, body, out any) {
+	t.Helper()
+	req := sendgrid.GetRequest(os.Getenv("SENDGRID_API_KEY"), path, baseURL())
+	req.Method = "GET"
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			t.Fatalf("encode %s: %v", path, err)
+		}
+		req.Method = "POST"
+		req.Body = b
+	}
+	resp, err := sendgrid.API(req)
+	if err != nil {
+		t.Fatalf("fixture %s %s: %v", req.Method, path, err)
+	}
+	if resp.StatusCode >= 300 {
+		t.Fatalf("fixture %s %s: status=%d body=%s", req.Method, path, resp.StatusCode, resp.Body)
+	}
+	if out != nil {
+		if err := json.Unmarshal([]byte(resp.Body), out); err != nil {
+			t.Fatalf("fixture %s %s: unable to parse body: %v", req.Method, path, err)
+		}
+	}
+}
+
+// cleanup deletes a fixture, tolerating objects the test already removed.
+func cleanup(t *testing.T, path string) {
+	req := sendgrid.GetRequest(os.Getenv("SENDGRID_API_KEY"), path, baseURL())
+	req.Method = "DELETE"
+	resp, err := sendgrid.API(req)
+	switch {
+	case err != nil:
+		t.Errorf("fixture cleanup DELETE %s: %v (delete it manually)", path, err)
+	case resp.StatusCode >= 300 && resp.StatusCode != 404:
+		t.Errorf("fixture cleanup DELETE %s: status=%d body=%s (delete it manually)", path, resp.StatusCode, resp.Body)
+	}
+}